@@ -14,15 +14,539 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_2_list)(nil)
+
+type _GenesisState_2_list struct {
+	list *[]*Post
+}
+
+func (x *_GenesisState_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Post)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Post)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_2_list) AppendMutable() protoreflect.Value {
+	v := new(Post)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_2_list) NewElement() protoreflect.Value {
+	v := new(Post)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*PostRevision
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PostRevision)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PostRevision)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(PostRevision)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(PostRevision)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*Comment
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Comment)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Comment)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(Comment)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(Comment)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*Reaction
+}
+
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Reaction)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Reaction)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(Reaction)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(Reaction)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*AuthorTips
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AuthorTips)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AuthorTips)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(AuthorTips)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(AuthorTips)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*AuthorMigration
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AuthorMigration)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AuthorMigration)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(AuthorMigration)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(AuthorMigration)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*Report
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Report)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Report)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(Report)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(Report)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]uint64
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field ReviewQueue as it is not of Message kind"))
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]string
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message GenesisState at list field Blocklist as it is not of Message kind"))
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*FeaturedPost
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeaturedPost)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeaturedPost)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(FeaturedPost)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(FeaturedPost)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
+	md_GenesisState                     protoreflect.MessageDescriptor
+	fd_GenesisState_params              protoreflect.FieldDescriptor
+	fd_GenesisState_postList            protoreflect.FieldDescriptor
+	fd_GenesisState_postCount           protoreflect.FieldDescriptor
+	fd_GenesisState_postRevisionList    protoreflect.FieldDescriptor
+	fd_GenesisState_commentList         protoreflect.FieldDescriptor
+	fd_GenesisState_commentCount        protoreflect.FieldDescriptor
+	fd_GenesisState_reactionList        protoreflect.FieldDescriptor
+	fd_GenesisState_authorTipsList      protoreflect.FieldDescriptor
+	fd_GenesisState_authorMigrationList protoreflect.FieldDescriptor
+	fd_GenesisState_reportList          protoreflect.FieldDescriptor
+	fd_GenesisState_reviewQueue         protoreflect.FieldDescriptor
+	fd_GenesisState_blocklist           protoreflect.FieldDescriptor
+	fd_GenesisState_featuredPostList    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_blog_blog_genesis_proto_init()
 	md_GenesisState = File_blog_blog_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_postList = md_GenesisState.Fields().ByName("postList")
+	fd_GenesisState_postCount = md_GenesisState.Fields().ByName("postCount")
+	fd_GenesisState_postRevisionList = md_GenesisState.Fields().ByName("postRevisionList")
+	fd_GenesisState_commentList = md_GenesisState.Fields().ByName("commentList")
+	fd_GenesisState_commentCount = md_GenesisState.Fields().ByName("commentCount")
+	fd_GenesisState_reactionList = md_GenesisState.Fields().ByName("reactionList")
+	fd_GenesisState_authorTipsList = md_GenesisState.Fields().ByName("authorTipsList")
+	fd_GenesisState_authorMigrationList = md_GenesisState.Fields().ByName("authorMigrationList")
+	fd_GenesisState_reportList = md_GenesisState.Fields().ByName("reportList")
+	fd_GenesisState_reviewQueue = md_GenesisState.Fields().ByName("reviewQueue")
+	fd_GenesisState_blocklist = md_GenesisState.Fields().ByName("blocklist")
+	fd_GenesisState_featuredPostList = md_GenesisState.Fields().ByName("featuredPostList")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -96,6 +620,78 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.PostList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.PostList})
+		if !f(fd_GenesisState_postList, value) {
+			return
+		}
+	}
+	if x.PostCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PostCount)
+		if !f(fd_GenesisState_postCount, value) {
+			return
+		}
+	}
+	if len(x.PostRevisionList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.PostRevisionList})
+		if !f(fd_GenesisState_postRevisionList, value) {
+			return
+		}
+	}
+	if len(x.CommentList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.CommentList})
+		if !f(fd_GenesisState_commentList, value) {
+			return
+		}
+	}
+	if x.CommentCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CommentCount)
+		if !f(fd_GenesisState_commentCount, value) {
+			return
+		}
+	}
+	if len(x.ReactionList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.ReactionList})
+		if !f(fd_GenesisState_reactionList, value) {
+			return
+		}
+	}
+	if len(x.AuthorTipsList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.AuthorTipsList})
+		if !f(fd_GenesisState_authorTipsList, value) {
+			return
+		}
+	}
+	if len(x.AuthorMigrationList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.AuthorMigrationList})
+		if !f(fd_GenesisState_authorMigrationList, value) {
+			return
+		}
+	}
+	if len(x.ReportList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.ReportList})
+		if !f(fd_GenesisState_reportList, value) {
+			return
+		}
+	}
+	if len(x.ReviewQueue) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.ReviewQueue})
+		if !f(fd_GenesisState_reviewQueue, value) {
+			return
+		}
+	}
+	if len(x.Blocklist) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.Blocklist})
+		if !f(fd_GenesisState_blocklist, value) {
+			return
+		}
+	}
+	if len(x.FeaturedPostList) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.FeaturedPostList})
+		if !f(fd_GenesisState_featuredPostList, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -113,6 +709,30 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 	switch fd.FullName() {
 	case "blog.blog.GenesisState.params":
 		return x.Params != nil
+	case "blog.blog.GenesisState.postList":
+		return len(x.PostList) != 0
+	case "blog.blog.GenesisState.postCount":
+		return x.PostCount != uint64(0)
+	case "blog.blog.GenesisState.postRevisionList":
+		return len(x.PostRevisionList) != 0
+	case "blog.blog.GenesisState.commentList":
+		return len(x.CommentList) != 0
+	case "blog.blog.GenesisState.commentCount":
+		return x.CommentCount != uint64(0)
+	case "blog.blog.GenesisState.reactionList":
+		return len(x.ReactionList) != 0
+	case "blog.blog.GenesisState.authorTipsList":
+		return len(x.AuthorTipsList) != 0
+	case "blog.blog.GenesisState.authorMigrationList":
+		return len(x.AuthorMigrationList) != 0
+	case "blog.blog.GenesisState.reportList":
+		return len(x.ReportList) != 0
+	case "blog.blog.GenesisState.reviewQueue":
+		return len(x.ReviewQueue) != 0
+	case "blog.blog.GenesisState.blocklist":
+		return len(x.Blocklist) != 0
+	case "blog.blog.GenesisState.featuredPostList":
+		return len(x.FeaturedPostList) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.GenesisState"))
@@ -131,6 +751,30 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "blog.blog.GenesisState.params":
 		x.Params = nil
+	case "blog.blog.GenesisState.postList":
+		x.PostList = nil
+	case "blog.blog.GenesisState.postCount":
+		x.PostCount = uint64(0)
+	case "blog.blog.GenesisState.postRevisionList":
+		x.PostRevisionList = nil
+	case "blog.blog.GenesisState.commentList":
+		x.CommentList = nil
+	case "blog.blog.GenesisState.commentCount":
+		x.CommentCount = uint64(0)
+	case "blog.blog.GenesisState.reactionList":
+		x.ReactionList = nil
+	case "blog.blog.GenesisState.authorTipsList":
+		x.AuthorTipsList = nil
+	case "blog.blog.GenesisState.authorMigrationList":
+		x.AuthorMigrationList = nil
+	case "blog.blog.GenesisState.reportList":
+		x.ReportList = nil
+	case "blog.blog.GenesisState.reviewQueue":
+		x.ReviewQueue = nil
+	case "blog.blog.GenesisState.blocklist":
+		x.Blocklist = nil
+	case "blog.blog.GenesisState.featuredPostList":
+		x.FeaturedPostList = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.GenesisState"))
@@ -150,6 +794,72 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "blog.blog.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "blog.blog.GenesisState.postList":
+		if len(x.PostList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.PostList}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.GenesisState.postCount":
+		value := x.PostCount
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.GenesisState.postRevisionList":
+		if len(x.PostRevisionList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.PostRevisionList}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.GenesisState.commentList":
+		if len(x.CommentList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.CommentList}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.GenesisState.commentCount":
+		value := x.CommentCount
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.GenesisState.reactionList":
+		if len(x.ReactionList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.ReactionList}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.GenesisState.authorTipsList":
+		if len(x.AuthorTipsList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.AuthorTipsList}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.GenesisState.authorMigrationList":
+		if len(x.AuthorMigrationList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.AuthorMigrationList}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.GenesisState.reportList":
+		if len(x.ReportList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.ReportList}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.GenesisState.reviewQueue":
+		if len(x.ReviewQueue) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.ReviewQueue}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.GenesisState.blocklist":
+		if len(x.Blocklist) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.Blocklist}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.GenesisState.featuredPostList":
+		if len(x.FeaturedPostList) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.FeaturedPostList}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.GenesisState"))
@@ -172,6 +882,50 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 	switch fd.FullName() {
 	case "blog.blog.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "blog.blog.GenesisState.postList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.PostList = *clv.list
+	case "blog.blog.GenesisState.postCount":
+		x.PostCount = value.Uint()
+	case "blog.blog.GenesisState.postRevisionList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.PostRevisionList = *clv.list
+	case "blog.blog.GenesisState.commentList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.CommentList = *clv.list
+	case "blog.blog.GenesisState.commentCount":
+		x.CommentCount = value.Uint()
+	case "blog.blog.GenesisState.reactionList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.ReactionList = *clv.list
+	case "blog.blog.GenesisState.authorTipsList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.AuthorTipsList = *clv.list
+	case "blog.blog.GenesisState.authorMigrationList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.AuthorMigrationList = *clv.list
+	case "blog.blog.GenesisState.reportList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.ReportList = *clv.list
+	case "blog.blog.GenesisState.reviewQueue":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.ReviewQueue = *clv.list
+	case "blog.blog.GenesisState.blocklist":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.Blocklist = *clv.list
+	case "blog.blog.GenesisState.featuredPostList":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.FeaturedPostList = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.GenesisState"))
@@ -197,8 +951,72 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	default:
-		if fd.IsExtension() {
+	case "blog.blog.GenesisState.postList":
+		if x.PostList == nil {
+			x.PostList = []*Post{}
+		}
+		value := &_GenesisState_2_list{list: &x.PostList}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.GenesisState.postRevisionList":
+		if x.PostRevisionList == nil {
+			x.PostRevisionList = []*PostRevision{}
+		}
+		value := &_GenesisState_4_list{list: &x.PostRevisionList}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.GenesisState.commentList":
+		if x.CommentList == nil {
+			x.CommentList = []*Comment{}
+		}
+		value := &_GenesisState_5_list{list: &x.CommentList}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.GenesisState.reactionList":
+		if x.ReactionList == nil {
+			x.ReactionList = []*Reaction{}
+		}
+		value := &_GenesisState_7_list{list: &x.ReactionList}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.GenesisState.authorTipsList":
+		if x.AuthorTipsList == nil {
+			x.AuthorTipsList = []*AuthorTips{}
+		}
+		value := &_GenesisState_8_list{list: &x.AuthorTipsList}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.GenesisState.authorMigrationList":
+		if x.AuthorMigrationList == nil {
+			x.AuthorMigrationList = []*AuthorMigration{}
+		}
+		value := &_GenesisState_9_list{list: &x.AuthorMigrationList}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.GenesisState.reportList":
+		if x.ReportList == nil {
+			x.ReportList = []*Report{}
+		}
+		value := &_GenesisState_10_list{list: &x.ReportList}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.GenesisState.reviewQueue":
+		if x.ReviewQueue == nil {
+			x.ReviewQueue = []uint64{}
+		}
+		value := &_GenesisState_11_list{list: &x.ReviewQueue}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.GenesisState.blocklist":
+		if x.Blocklist == nil {
+			x.Blocklist = []string{}
+		}
+		value := &_GenesisState_12_list{list: &x.Blocklist}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.GenesisState.featuredPostList":
+		if x.FeaturedPostList == nil {
+			x.FeaturedPostList = []*FeaturedPost{}
+		}
+		value := &_GenesisState_13_list{list: &x.FeaturedPostList}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.GenesisState.postCount":
+		panic(fmt.Errorf("field postCount of message blog.blog.GenesisState is not mutable"))
+	case "blog.blog.GenesisState.commentCount":
+		panic(fmt.Errorf("field commentCount of message blog.blog.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.GenesisState"))
 		}
 		panic(fmt.Errorf("message blog.blog.GenesisState does not contain field %s", fd.FullName()))
@@ -213,6 +1031,40 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "blog.blog.GenesisState.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "blog.blog.GenesisState.postList":
+		list := []*Post{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "blog.blog.GenesisState.postCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.GenesisState.postRevisionList":
+		list := []*PostRevision{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "blog.blog.GenesisState.commentList":
+		list := []*Comment{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "blog.blog.GenesisState.commentCount":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.GenesisState.reactionList":
+		list := []*Reaction{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "blog.blog.GenesisState.authorTipsList":
+		list := []*AuthorTips{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "blog.blog.GenesisState.authorMigrationList":
+		list := []*AuthorMigration{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "blog.blog.GenesisState.reportList":
+		list := []*Report{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "blog.blog.GenesisState.reviewQueue":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "blog.blog.GenesisState.blocklist":
+		list := []string{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "blog.blog.GenesisState.featuredPostList":
+		list := []*FeaturedPost{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.GenesisState"))
@@ -286,6 +1138,73 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.PostList) > 0 {
+			for _, e := range x.PostList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.PostCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.PostCount))
+		}
+		if len(x.PostRevisionList) > 0 {
+			for _, e := range x.PostRevisionList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CommentList) > 0 {
+			for _, e := range x.CommentList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CommentCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.CommentCount))
+		}
+		if len(x.ReactionList) > 0 {
+			for _, e := range x.ReactionList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AuthorTipsList) > 0 {
+			for _, e := range x.AuthorTipsList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AuthorMigrationList) > 0 {
+			for _, e := range x.AuthorMigrationList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReportList) > 0 {
+			for _, e := range x.ReportList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ReviewQueue) > 0 {
+			l = 0
+			for _, e := range x.ReviewQueue {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
+		}
+		if len(x.Blocklist) > 0 {
+			for _, s := range x.Blocklist {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FeaturedPostList) > 0 {
+			for _, e := range x.FeaturedPostList {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -315,6 +1234,173 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.FeaturedPostList) > 0 {
+			for iNdEx := len(x.FeaturedPostList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeaturedPostList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.Blocklist) > 0 {
+			for iNdEx := len(x.Blocklist) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Blocklist[iNdEx])
+				copy(dAtA[i:], x.Blocklist[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Blocklist[iNdEx])))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.ReviewQueue) > 0 {
+			var pksize2 int
+			for _, num := range x.ReviewQueue {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.ReviewQueue {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.ReportList) > 0 {
+			for iNdEx := len(x.ReportList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReportList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.AuthorMigrationList) > 0 {
+			for iNdEx := len(x.AuthorMigrationList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AuthorMigrationList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.AuthorTipsList) > 0 {
+			for iNdEx := len(x.AuthorTipsList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AuthorTipsList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.ReactionList) > 0 {
+			for iNdEx := len(x.ReactionList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReactionList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.CommentCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CommentCount))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.CommentList) > 0 {
+			for iNdEx := len(x.CommentList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CommentList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.PostRevisionList) > 0 {
+			for iNdEx := len(x.PostRevisionList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PostRevisionList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.PostCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PostCount))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.PostList) > 0 {
+			for iNdEx := len(x.PostList) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PostList[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -414,6 +1500,424 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PostList = append(x.PostList, &Post{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PostList[len(x.PostList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostCount", wireType)
+				}
+				x.PostCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PostCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostRevisionList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PostRevisionList = append(x.PostRevisionList, &PostRevision{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PostRevisionList[len(x.PostRevisionList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommentList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CommentList = append(x.CommentList, &Comment{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommentList[len(x.CommentList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommentCount", wireType)
+				}
+				x.CommentCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CommentCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReactionList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReactionList = append(x.ReactionList, &Reaction{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReactionList[len(x.ReactionList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthorTipsList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuthorTipsList = append(x.AuthorTipsList, &AuthorTips{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AuthorTipsList[len(x.AuthorTipsList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthorMigrationList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuthorMigrationList = append(x.AuthorMigrationList, &AuthorMigration{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AuthorMigrationList[len(x.AuthorMigrationList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReportList = append(x.ReportList, &Report{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReportList[len(x.ReportList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.ReviewQueue = append(x.ReviewQueue, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.ReviewQueue) == 0 {
+						x.ReviewQueue = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.ReviewQueue = append(x.ReviewQueue, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReviewQueue", wireType)
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Blocklist", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Blocklist = append(x.Blocklist, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeaturedPostList", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeaturedPostList = append(x.FeaturedPostList, &FeaturedPost{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeaturedPostList[len(x.FeaturedPostList)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -470,6 +1974,30 @@ type GenesisState struct {
 
 	// params defines all the parameters of the module.
 	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// postList holds every post stored by the module.
+	PostList []*Post `protobuf:"bytes,2,rep,name=postList,proto3" json:"postList,omitempty"`
+	// postCount is the ID that will be assigned to the next post.
+	PostCount uint64 `protobuf:"varint,3,opt,name=postCount,proto3" json:"postCount,omitempty"`
+	// postRevisionList holds the saved previous versions of every post.
+	PostRevisionList []*PostRevision `protobuf:"bytes,4,rep,name=postRevisionList,proto3" json:"postRevisionList,omitempty"`
+	// commentList holds every comment stored by the module.
+	CommentList []*Comment `protobuf:"bytes,5,rep,name=commentList,proto3" json:"commentList,omitempty"`
+	// commentCount is the ID that will be assigned to the next comment.
+	CommentCount uint64 `protobuf:"varint,6,opt,name=commentCount,proto3" json:"commentCount,omitempty"`
+	// reactionList holds every reaction left on a post.
+	ReactionList []*Reaction `protobuf:"bytes,7,rep,name=reactionList,proto3" json:"reactionList,omitempty"`
+	// authorTipsList holds the cumulative tips received by every author.
+	AuthorTipsList []*AuthorTips `protobuf:"bytes,8,rep,name=authorTipsList,proto3" json:"authorTipsList,omitempty"`
+	// authorMigrationList holds the author migrations still in progress.
+	AuthorMigrationList []*AuthorMigration `protobuf:"bytes,9,rep,name=authorMigrationList,proto3" json:"authorMigrationList,omitempty"`
+	// reportList holds every report filed against a post.
+	ReportList []*Report `protobuf:"bytes,10,rep,name=reportList,proto3" json:"reportList,omitempty"`
+	// reviewQueue holds the IDs of the posts hidden by reports and awaiting review.
+	ReviewQueue []uint64 `protobuf:"varint,11,rep,packed,name=reviewQueue,proto3" json:"reviewQueue,omitempty"`
+	// blocklist holds the addresses blocked from posting and interacting.
+	Blocklist []string `protobuf:"bytes,12,rep,name=blocklist,proto3" json:"blocklist,omitempty"`
+	// featuredPostList holds the posts featured by governance, in order.
+	FeaturedPostList []*FeaturedPost `protobuf:"bytes,13,rep,name=featuredPostList,proto3" json:"featuredPostList,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -499,6 +2027,90 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetPostList() []*Post {
+	if x != nil {
+		return x.PostList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPostCount() uint64 {
+	if x != nil {
+		return x.PostCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetPostRevisionList() []*PostRevision {
+	if x != nil {
+		return x.PostRevisionList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCommentList() []*Comment {
+	if x != nil {
+		return x.CommentList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCommentCount() uint64 {
+	if x != nil {
+		return x.CommentCount
+	}
+	return 0
+}
+
+func (x *GenesisState) GetReactionList() []*Reaction {
+	if x != nil {
+		return x.ReactionList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAuthorTipsList() []*AuthorTips {
+	if x != nil {
+		return x.AuthorTipsList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAuthorMigrationList() []*AuthorMigration {
+	if x != nil {
+		return x.AuthorMigrationList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetReportList() []*Report {
+	if x != nil {
+		return x.ReportList
+	}
+	return nil
+}
+
+func (x *GenesisState) GetReviewQueue() []uint64 {
+	if x != nil {
+		return x.ReviewQueue
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBlocklist() []string {
+	if x != nil {
+		return x.Blocklist
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFeaturedPostList() []*FeaturedPost {
+	if x != nil {
+		return x.FeaturedPostList
+	}
+	return nil
+}
+
 var File_blog_blog_genesis_proto protoreflect.FileDescriptor
 
 var file_blog_blog_genesis_proto_rawDesc = []byte{
@@ -508,19 +2120,72 @@ var file_blog_blog_genesis_proto_rawDesc = []byte{
 	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x62,
 	0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x44, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62, 0x6c, 0x6f,
-	0x67, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x76, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6d, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x42, 0x0c, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x12, 0x62, 0x6c,
-	0x6f, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67,
-	0xa2, 0x02, 0x03, 0x42, 0x42, 0x58, 0xaa, 0x02, 0x09, 0x42, 0x6c, 0x6f, 0x67, 0x2e, 0x42, 0x6c,
-	0x6f, 0x67, 0xca, 0x02, 0x09, 0x42, 0x6c, 0x6f, 0x67, 0x5c, 0x42, 0x6c, 0x6f, 0x67, 0xe2, 0x02,
-	0x15, 0x42, 0x6c, 0x6f, 0x67, 0x5c, 0x42, 0x6c, 0x6f, 0x67, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x42, 0x6c, 0x6f, 0x67, 0x3a, 0x3a, 0x42,
-	0x6c, 0x6f, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67,
+	0x2f, 0x70, 0x6f, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x62, 0x6c, 0x6f,
+	0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2f,
+	0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13,
+	0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x74, 0x69, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67,
+	0x2f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x62,
+	0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdc, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65,
+	0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e,
+	0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x31,
+	0x0a, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x50, 0x6f, 0x73,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x70, 0x6f, 0x73, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x49, 0x0a, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6c, 0x6f, 0x67,
+	0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0e, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x54, 0x69, 0x70, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x54, 0x69, 0x70, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0e,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x54, 0x69, 0x70, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x52,
+	0x0a, 0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x62, 0x6c,
+	0x6f, 0x67, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62, 0x6c,
+	0x6f, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x10, 0x66,
+	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x18,
+	0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62, 0x6c, 0x6f,
+	0x67, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x73, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x64, 0x50, 0x6f,
+	0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x76, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x6c,
+	0x6f, 0x67, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x12, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0xa2, 0x02, 0x03, 0x42, 0x42,
+	0x58, 0xaa, 0x02, 0x09, 0x42, 0x6c, 0x6f, 0x67, 0x2e, 0x42, 0x6c, 0x6f, 0x67, 0xca, 0x02, 0x09,
+	0x42, 0x6c, 0x6f, 0x67, 0x5c, 0x42, 0x6c, 0x6f, 0x67, 0xe2, 0x02, 0x15, 0x42, 0x6c, 0x6f, 0x67,
+	0x5c, 0x42, 0x6c, 0x6f, 0x67, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x0a, 0x42, 0x6c, 0x6f, 0x67, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x67, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -537,16 +2202,32 @@ func file_blog_blog_genesis_proto_rawDescGZIP() []byte {
 
 var file_blog_blog_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_blog_blog_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: blog.blog.GenesisState
-	(*Params)(nil),       // 1: blog.blog.Params
+	(*GenesisState)(nil),    // 0: blog.blog.GenesisState
+	(*Params)(nil),          // 1: blog.blog.Params
+	(*Post)(nil),            // 2: blog.blog.Post
+	(*PostRevision)(nil),    // 3: blog.blog.PostRevision
+	(*Comment)(nil),         // 4: blog.blog.Comment
+	(*Reaction)(nil),        // 5: blog.blog.Reaction
+	(*AuthorTips)(nil),      // 6: blog.blog.AuthorTips
+	(*AuthorMigration)(nil), // 7: blog.blog.AuthorMigration
+	(*Report)(nil),          // 8: blog.blog.Report
+	(*FeaturedPost)(nil),    // 9: blog.blog.FeaturedPost
 }
 var file_blog_blog_genesis_proto_depIdxs = []int32{
 	1, // 0: blog.blog.GenesisState.params:type_name -> blog.blog.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: blog.blog.GenesisState.postList:type_name -> blog.blog.Post
+	3, // 2: blog.blog.GenesisState.postRevisionList:type_name -> blog.blog.PostRevision
+	4, // 3: blog.blog.GenesisState.commentList:type_name -> blog.blog.Comment
+	5, // 4: blog.blog.GenesisState.reactionList:type_name -> blog.blog.Reaction
+	6, // 5: blog.blog.GenesisState.authorTipsList:type_name -> blog.blog.AuthorTips
+	7, // 6: blog.blog.GenesisState.authorMigrationList:type_name -> blog.blog.AuthorMigration
+	8, // 7: blog.blog.GenesisState.reportList:type_name -> blog.blog.Report
+	9, // 8: blog.blog.GenesisState.featuredPostList:type_name -> blog.blog.FeaturedPost
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_blog_blog_genesis_proto_init() }
@@ -555,6 +2236,13 @@ func file_blog_blog_genesis_proto_init() {
 		return
 	}
 	file_blog_blog_params_proto_init()
+	file_blog_blog_post_proto_init()
+	file_blog_blog_comment_proto_init()
+	file_blog_blog_reaction_proto_init()
+	file_blog_blog_tip_proto_init()
+	file_blog_blog_author_migration_proto_init()
+	file_blog_blog_report_proto_init()
+	file_blog_blog_featured_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_blog_blog_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -3,24 +3,94 @@ package blog
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_10_list)(nil)
+
+type _Params_10_list struct {
+	list *[]string
+}
+
+func (x *_Params_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field Moderators as it is not of Message kind"))
+}
+
+func (x *_Params_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_Params                     protoreflect.MessageDescriptor
+	fd_Params_max_revisions       protoreflect.FieldDescriptor
+	fd_Params_delete_grace_period protoreflect.FieldDescriptor
+	fd_Params_max_comment_depth   protoreflect.FieldDescriptor
+	fd_Params_max_tags            protoreflect.FieldDescriptor
+	fd_Params_max_tag_length      protoreflect.FieldDescriptor
+	fd_Params_max_title_bytes     protoreflect.FieldDescriptor
+	fd_Params_max_body_bytes      protoreflect.FieldDescriptor
+	fd_Params_post_fee            protoreflect.FieldDescriptor
+	fd_Params_deposit_per_byte    protoreflect.FieldDescriptor
+	fd_Params_moderators          protoreflect.FieldDescriptor
+	fd_Params_report_threshold    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_blog_blog_params_proto_init()
 	md_Params = File_blog_blog_params_proto.Messages().ByName("Params")
+	fd_Params_max_revisions = md_Params.Fields().ByName("max_revisions")
+	fd_Params_delete_grace_period = md_Params.Fields().ByName("delete_grace_period")
+	fd_Params_max_comment_depth = md_Params.Fields().ByName("max_comment_depth")
+	fd_Params_max_tags = md_Params.Fields().ByName("max_tags")
+	fd_Params_max_tag_length = md_Params.Fields().ByName("max_tag_length")
+	fd_Params_max_title_bytes = md_Params.Fields().ByName("max_title_bytes")
+	fd_Params_max_body_bytes = md_Params.Fields().ByName("max_body_bytes")
+	fd_Params_post_fee = md_Params.Fields().ByName("post_fee")
+	fd_Params_deposit_per_byte = md_Params.Fields().ByName("deposit_per_byte")
+	fd_Params_moderators = md_Params.Fields().ByName("moderators")
+	fd_Params_report_threshold = md_Params.Fields().ByName("report_threshold")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -88,6 +158,72 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
 func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MaxRevisions != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxRevisions)
+		if !f(fd_Params_max_revisions, value) {
+			return
+		}
+	}
+	if x.DeleteGracePeriod != nil {
+		value := protoreflect.ValueOfMessage(x.DeleteGracePeriod.ProtoReflect())
+		if !f(fd_Params_delete_grace_period, value) {
+			return
+		}
+	}
+	if x.MaxCommentDepth != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxCommentDepth)
+		if !f(fd_Params_max_comment_depth, value) {
+			return
+		}
+	}
+	if x.MaxTags != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTags)
+		if !f(fd_Params_max_tags, value) {
+			return
+		}
+	}
+	if x.MaxTagLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTagLength)
+		if !f(fd_Params_max_tag_length, value) {
+			return
+		}
+	}
+	if x.MaxTitleBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxTitleBytes)
+		if !f(fd_Params_max_title_bytes, value) {
+			return
+		}
+	}
+	if x.MaxBodyBytes != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxBodyBytes)
+		if !f(fd_Params_max_body_bytes, value) {
+			return
+		}
+	}
+	if x.PostFee != nil {
+		value := protoreflect.ValueOfMessage(x.PostFee.ProtoReflect())
+		if !f(fd_Params_post_fee, value) {
+			return
+		}
+	}
+	if x.DepositPerByte != nil {
+		value := protoreflect.ValueOfMessage(x.DepositPerByte.ProtoReflect())
+		if !f(fd_Params_deposit_per_byte, value) {
+			return
+		}
+	}
+	if len(x.Moderators) != 0 {
+		value := protoreflect.ValueOfList(&_Params_10_list{list: &x.Moderators})
+		if !f(fd_Params_moderators, value) {
+			return
+		}
+	}
+	if x.ReportThreshold != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReportThreshold)
+		if !f(fd_Params_report_threshold, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -103,6 +239,28 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // a repeated field is populated if it is non-empty.
 func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "blog.blog.Params.max_revisions":
+		return x.MaxRevisions != uint64(0)
+	case "blog.blog.Params.delete_grace_period":
+		return x.DeleteGracePeriod != nil
+	case "blog.blog.Params.max_comment_depth":
+		return x.MaxCommentDepth != uint64(0)
+	case "blog.blog.Params.max_tags":
+		return x.MaxTags != uint64(0)
+	case "blog.blog.Params.max_tag_length":
+		return x.MaxTagLength != uint64(0)
+	case "blog.blog.Params.max_title_bytes":
+		return x.MaxTitleBytes != uint64(0)
+	case "blog.blog.Params.max_body_bytes":
+		return x.MaxBodyBytes != uint64(0)
+	case "blog.blog.Params.post_fee":
+		return x.PostFee != nil
+	case "blog.blog.Params.deposit_per_byte":
+		return x.DepositPerByte != nil
+	case "blog.blog.Params.moderators":
+		return len(x.Moderators) != 0
+	case "blog.blog.Params.report_threshold":
+		return x.ReportThreshold != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Params"))
@@ -119,6 +277,28 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // Clear is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "blog.blog.Params.max_revisions":
+		x.MaxRevisions = uint64(0)
+	case "blog.blog.Params.delete_grace_period":
+		x.DeleteGracePeriod = nil
+	case "blog.blog.Params.max_comment_depth":
+		x.MaxCommentDepth = uint64(0)
+	case "blog.blog.Params.max_tags":
+		x.MaxTags = uint64(0)
+	case "blog.blog.Params.max_tag_length":
+		x.MaxTagLength = uint64(0)
+	case "blog.blog.Params.max_title_bytes":
+		x.MaxTitleBytes = uint64(0)
+	case "blog.blog.Params.max_body_bytes":
+		x.MaxBodyBytes = uint64(0)
+	case "blog.blog.Params.post_fee":
+		x.PostFee = nil
+	case "blog.blog.Params.deposit_per_byte":
+		x.DepositPerByte = nil
+	case "blog.blog.Params.moderators":
+		x.Moderators = nil
+	case "blog.blog.Params.report_threshold":
+		x.ReportThreshold = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Params"))
@@ -135,6 +315,42 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // of the value; to obtain a mutable reference, use Mutable.
 func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "blog.blog.Params.max_revisions":
+		value := x.MaxRevisions
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.delete_grace_period":
+		value := x.DeleteGracePeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "blog.blog.Params.max_comment_depth":
+		value := x.MaxCommentDepth
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.max_tags":
+		value := x.MaxTags
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.max_tag_length":
+		value := x.MaxTagLength
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.max_title_bytes":
+		value := x.MaxTitleBytes
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.max_body_bytes":
+		value := x.MaxBodyBytes
+		return protoreflect.ValueOfUint64(value)
+	case "blog.blog.Params.post_fee":
+		value := x.PostFee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "blog.blog.Params.deposit_per_byte":
+		value := x.DepositPerByte
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "blog.blog.Params.moderators":
+		if len(x.Moderators) == 0 {
+			return protoreflect.ValueOfList(&_Params_10_list{})
+		}
+		listValue := &_Params_10_list{list: &x.Moderators}
+		return protoreflect.ValueOfList(listValue)
+	case "blog.blog.Params.report_threshold":
+		value := x.ReportThreshold
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Params"))
@@ -155,6 +371,30 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // Set is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "blog.blog.Params.max_revisions":
+		x.MaxRevisions = value.Uint()
+	case "blog.blog.Params.delete_grace_period":
+		x.DeleteGracePeriod = value.Message().Interface().(*durationpb.Duration)
+	case "blog.blog.Params.max_comment_depth":
+		x.MaxCommentDepth = value.Uint()
+	case "blog.blog.Params.max_tags":
+		x.MaxTags = value.Uint()
+	case "blog.blog.Params.max_tag_length":
+		x.MaxTagLength = value.Uint()
+	case "blog.blog.Params.max_title_bytes":
+		x.MaxTitleBytes = value.Uint()
+	case "blog.blog.Params.max_body_bytes":
+		x.MaxBodyBytes = value.Uint()
+	case "blog.blog.Params.post_fee":
+		x.PostFee = value.Message().Interface().(*v1beta1.Coin)
+	case "blog.blog.Params.deposit_per_byte":
+		x.DepositPerByte = value.Message().Interface().(*v1beta1.Coin)
+	case "blog.blog.Params.moderators":
+		lv := value.List()
+		clv := lv.(*_Params_10_list)
+		x.Moderators = *clv.list
+	case "blog.blog.Params.report_threshold":
+		x.ReportThreshold = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Params"))
@@ -175,6 +415,41 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "blog.blog.Params.delete_grace_period":
+		if x.DeleteGracePeriod == nil {
+			x.DeleteGracePeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DeleteGracePeriod.ProtoReflect())
+	case "blog.blog.Params.post_fee":
+		if x.PostFee == nil {
+			x.PostFee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.PostFee.ProtoReflect())
+	case "blog.blog.Params.deposit_per_byte":
+		if x.DepositPerByte == nil {
+			x.DepositPerByte = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.DepositPerByte.ProtoReflect())
+	case "blog.blog.Params.moderators":
+		if x.Moderators == nil {
+			x.Moderators = []string{}
+		}
+		value := &_Params_10_list{list: &x.Moderators}
+		return protoreflect.ValueOfList(value)
+	case "blog.blog.Params.max_revisions":
+		panic(fmt.Errorf("field max_revisions of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.max_comment_depth":
+		panic(fmt.Errorf("field max_comment_depth of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.max_tags":
+		panic(fmt.Errorf("field max_tags of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.max_tag_length":
+		panic(fmt.Errorf("field max_tag_length of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.max_title_bytes":
+		panic(fmt.Errorf("field max_title_bytes of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.max_body_bytes":
+		panic(fmt.Errorf("field max_body_bytes of message blog.blog.Params is not mutable"))
+	case "blog.blog.Params.report_threshold":
+		panic(fmt.Errorf("field report_threshold of message blog.blog.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Params"))
@@ -188,6 +463,32 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 // For lists, maps, and messages, this returns a new, empty, mutable value.
 func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "blog.blog.Params.max_revisions":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.delete_grace_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "blog.blog.Params.max_comment_depth":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.max_tags":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.max_tag_length":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.max_title_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.max_body_bytes":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "blog.blog.Params.post_fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "blog.blog.Params.deposit_per_byte":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "blog.blog.Params.moderators":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_10_list{list: &list})
+	case "blog.blog.Params.report_threshold":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: blog.blog.Params"))
@@ -257,6 +558,45 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.MaxRevisions != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRevisions))
+		}
+		if x.DeleteGracePeriod != nil {
+			l = options.Size(x.DeleteGracePeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxCommentDepth != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxCommentDepth))
+		}
+		if x.MaxTags != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTags))
+		}
+		if x.MaxTagLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTagLength))
+		}
+		if x.MaxTitleBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxTitleBytes))
+		}
+		if x.MaxBodyBytes != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxBodyBytes))
+		}
+		if x.PostFee != nil {
+			l = options.Size(x.PostFee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DepositPerByte != nil {
+			l = options.Size(x.DepositPerByte)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Moderators) > 0 {
+			for _, s := range x.Moderators {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.ReportThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReportThreshold))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -286,6 +626,92 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ReportThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReportThreshold))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.Moderators) > 0 {
+			for iNdEx := len(x.Moderators) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Moderators[iNdEx])
+				copy(dAtA[i:], x.Moderators[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Moderators[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if x.DepositPerByte != nil {
+			encoded, err := options.Marshal(x.DepositPerByte)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.PostFee != nil {
+			encoded, err := options.Marshal(x.PostFee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.MaxBodyBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxBodyBytes))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.MaxTitleBytes != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTitleBytes))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.MaxTagLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTagLength))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.MaxTags != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxTags))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxCommentDepth != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCommentDepth))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.DeleteGracePeriod != nil {
+			encoded, err := options.Marshal(x.DeleteGracePeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.MaxRevisions != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRevisions))
+			i--
+			dAtA[i] = 0x8
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -335,6 +761,279 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRevisions", wireType)
+				}
+				x.MaxRevisions = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxRevisions |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeleteGracePeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DeleteGracePeriod == nil {
+					x.DeleteGracePeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DeleteGracePeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCommentDepth", wireType)
+				}
+				x.MaxCommentDepth = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxCommentDepth |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTags", wireType)
+				}
+				x.MaxTags = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTags |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTagLength", wireType)
+				}
+				x.MaxTagLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTagLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxTitleBytes", wireType)
+				}
+				x.MaxTitleBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxTitleBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxBodyBytes", wireType)
+				}
+				x.MaxBodyBytes = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxBodyBytes |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PostFee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PostFee == nil {
+					x.PostFee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PostFee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DepositPerByte", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DepositPerByte == nil {
+					x.DepositPerByte = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DepositPerByte); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Moderators", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Moderators = append(x.Moderators, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReportThreshold", wireType)
+				}
+				x.ReportThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReportThreshold |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -388,6 +1087,35 @@ type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// max_revisions is the number of previous versions kept for each post.
+	// Older revisions are pruned on update; zero disables the history.
+	MaxRevisions uint64 `protobuf:"varint,1,opt,name=max_revisions,json=maxRevisions,proto3" json:"max_revisions,omitempty"`
+	// delete_grace_period is how long a deleted post can still be restored by
+	// its creator before it is permanently purged.
+	DeleteGracePeriod *durationpb.Duration `protobuf:"bytes,2,opt,name=delete_grace_period,json=deleteGracePeriod,proto3" json:"delete_grace_period,omitempty"`
+	// max_comment_depth is the deepest nesting level a reply may have. Zero
+	// disables replies to comments.
+	MaxCommentDepth uint64 `protobuf:"varint,3,opt,name=max_comment_depth,json=maxCommentDepth,proto3" json:"max_comment_depth,omitempty"`
+	// max_tags is the number of tags a post may carry. Zero disables tags.
+	MaxTags uint64 `protobuf:"varint,4,opt,name=max_tags,json=maxTags,proto3" json:"max_tags,omitempty"`
+	// max_tag_length is the longest a single normalized tag may be, in bytes.
+	MaxTagLength uint64 `protobuf:"varint,5,opt,name=max_tag_length,json=maxTagLength,proto3" json:"max_tag_length,omitempty"`
+	// max_title_bytes is the longest a post title may be, in bytes.
+	MaxTitleBytes uint64 `protobuf:"varint,6,opt,name=max_title_bytes,json=maxTitleBytes,proto3" json:"max_title_bytes,omitempty"`
+	// max_body_bytes is the longest a post body may be, in bytes.
+	MaxBodyBytes uint64 `protobuf:"varint,7,opt,name=max_body_bytes,json=maxBodyBytes,proto3" json:"max_body_bytes,omitempty"`
+	// post_fee is charged to the creator of every post and paid into the blog
+	// module account.
+	PostFee *v1beta1.Coin `protobuf:"bytes,8,opt,name=post_fee,json=postFee,proto3" json:"post_fee,omitempty"`
+	// deposit_per_byte is escrowed in the blog module account for every byte
+	// of a post title and body, and refunded when the post is deleted.
+	DepositPerByte *v1beta1.Coin `protobuf:"bytes,9,opt,name=deposit_per_byte,json=depositPerByte,proto3" json:"deposit_per_byte,omitempty"`
+	// moderators are the accounts allowed to hide and unhide posts.
+	Moderators []string `protobuf:"bytes,10,rep,name=moderators,proto3" json:"moderators,omitempty"`
+	// report_threshold is the number of distinct reporters at which a post is
+	// hidden and queued for review. Zero disables automatic hiding.
+	ReportThreshold uint64 `protobuf:"varint,11,opt,name=report_threshold,json=reportThreshold,proto3" json:"report_threshold,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -410,6 +1138,83 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_blog_blog_params_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *Params) GetMaxRevisions() uint64 {
+	if x != nil {
+		return x.MaxRevisions
+	}
+	return 0
+}
+
+func (x *Params) GetDeleteGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.DeleteGracePeriod
+	}
+	return nil
+}
+
+func (x *Params) GetMaxCommentDepth() uint64 {
+	if x != nil {
+		return x.MaxCommentDepth
+	}
+	return 0
+}
+
+func (x *Params) GetMaxTags() uint64 {
+	if x != nil {
+		return x.MaxTags
+	}
+	return 0
+}
+
+func (x *Params) GetMaxTagLength() uint64 {
+	if x != nil {
+		return x.MaxTagLength
+	}
+	return 0
+}
+
+func (x *Params) GetMaxTitleBytes() uint64 {
+	if x != nil {
+		return x.MaxTitleBytes
+	}
+	return 0
+}
+
+func (x *Params) GetMaxBodyBytes() uint64 {
+	if x != nil {
+		return x.MaxBodyBytes
+	}
+	return 0
+}
+
+func (x *Params) GetPostFee() *v1beta1.Coin {
+	if x != nil {
+		return x.PostFee
+	}
+	return nil
+}
+
+func (x *Params) GetDepositPerByte() *v1beta1.Coin {
+	if x != nil {
+		return x.DepositPerByte
+	}
+	return nil
+}
+
+func (x *Params) GetModerators() []string {
+	if x != nil {
+		return x.Moderators
+	}
+	return nil
+}
+
+func (x *Params) GetReportThreshold() uint64 {
+	if x != nil {
+		return x.ReportThreshold
+	}
+	return 0
+}
+
 var File_blog_blog_params_proto protoreflect.FileDescriptor
 
 var file_blog_blog_params_proto_rawDesc = []byte{
@@ -417,18 +1222,73 @@ var file_blog_blog_params_proto_rawDesc = []byte{
 	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x62, 0x6c, 0x6f, 0x67, 0x2e, 0x62,
 	0x6c, 0x6f, 0x67, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x25, 0x0a, 0x06,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x1b, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x12, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x78, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x42, 0x75, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x2e,
-	0x62, 0x6c, 0x6f, 0x67, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x12, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62, 0x6c,
-	0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0xa2, 0x02, 0x03, 0x42, 0x42, 0x58, 0xaa, 0x02, 0x09,
-	0x42, 0x6c, 0x6f, 0x67, 0x2e, 0x42, 0x6c, 0x6f, 0x67, 0xca, 0x02, 0x09, 0x42, 0x6c, 0x6f, 0x67,
-	0x5c, 0x42, 0x6c, 0x6f, 0x67, 0xe2, 0x02, 0x15, 0x42, 0x6c, 0x6f, 0x67, 0x5c, 0x42, 0x6c, 0x6f,
-	0x67, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a,
-	0x42, 0x6c, 0x6f, 0x67, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x06, 0x0a,
+	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x72,
+	0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x18,
+	0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x71, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x26,
+	0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x22, 0x98, 0xdf, 0x1f, 0x01, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72,
+	0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x48, 0x0a, 0x11, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x70, 0x74,
+	0x68, 0x22, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x65,
+	0x70, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x13, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x61, 0x67, 0x73, 0x22, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x54,
+	0x61, 0x67, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x61, 0x67, 0x5f, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x19, 0xf2, 0xde, 0x1f,
+	0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x61, 0x67, 0x5f, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x54, 0x61, 0x67, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x42, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1a, 0xf2,
+	0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x19, 0xf2, 0xde, 0x1f, 0x15, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61, 0x78, 0x5f,
+	0x62, 0x6f, 0x64, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x42, 0x6f, 0x64, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x52, 0x0a, 0x08, 0x70, 0x6f, 0x73,
+	0x74, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x1c, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0f,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x22, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x70, 0x6f, 0x73, 0x74, 0x46, 0x65, 0x65, 0x12, 0x69, 0x0a,
+	0x10, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x24, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x50, 0x65, 0x72, 0x42, 0x79, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x15, 0xf2, 0xde,
+	0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x22, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x46, 0x0a, 0x10, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1b, 0xf2, 0xde, 0x1f, 0x17, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x1b, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x12, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x78, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x42, 0x75, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x62, 0x6c, 0x6f, 0x67,
+	0x2e, 0x62, 0x6c, 0x6f, 0x67, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x12, 0x62, 0x6c, 0x6f, 0x67, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x62,
+	0x6c, 0x6f, 0x67, 0x2f, 0x62, 0x6c, 0x6f, 0x67, 0xa2, 0x02, 0x03, 0x42, 0x42, 0x58, 0xaa, 0x02,
+	0x09, 0x42, 0x6c, 0x6f, 0x67, 0x2e, 0x42, 0x6c, 0x6f, 0x67, 0xca, 0x02, 0x09, 0x42, 0x6c, 0x6f,
+	0x67, 0x5c, 0x42, 0x6c, 0x6f, 0x67, 0xe2, 0x02, 0x15, 0x42, 0x6c, 0x6f, 0x67, 0x5c, 0x42, 0x6c,
+	0x6f, 0x67, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x0a, 0x42, 0x6c, 0x6f, 0x67, 0x3a, 0x3a, 0x42, 0x6c, 0x6f, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -445,14 +1305,19 @@ func file_blog_blog_params_proto_rawDescGZIP() []byte {
 
 var file_blog_blog_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_blog_blog_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: blog.blog.Params
+	(*Params)(nil),              // 0: blog.blog.Params
+	(*durationpb.Duration)(nil), // 1: google.protobuf.Duration
+	(*v1beta1.Coin)(nil),        // 2: cosmos.base.v1beta1.Coin
 }
 var file_blog_blog_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: blog.blog.Params.delete_grace_period:type_name -> google.protobuf.Duration
+	2, // 1: blog.blog.Params.post_fee:type_name -> cosmos.base.v1beta1.Coin
+	2, // 2: blog.blog.Params.deposit_per_byte:type_name -> cosmos.base.v1beta1.Coin
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_blog_blog_params_proto_init() }
@@ -19,9 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName   = "/blog.blog.Query/Params"
-	Query_ShowPost_FullMethodName = "/blog.blog.Query/ShowPost"
-	Query_ListPost_FullMethodName = "/blog.blog.Query/ListPost"
+	Query_Params_FullMethodName                 = "/blog.blog.Query/Params"
+	Query_ShowPost_FullMethodName               = "/blog.blog.Query/ShowPost"
+	Query_ListPost_FullMethodName               = "/blog.blog.Query/ListPost"
+	Query_ListPostRevisions_FullMethodName      = "/blog.blog.Query/ListPostRevisions"
+	Query_ShowPostRevision_FullMethodName       = "/blog.blog.Query/ShowPostRevision"
+	Query_ListCommentsByPost_FullMethodName     = "/blog.blog.Query/ListCommentsByPost"
+	Query_CommentThread_FullMethodName          = "/blog.blog.Query/CommentThread"
+	Query_ListPostsByCreator_FullMethodName     = "/blog.blog.Query/ListPostsByCreator"
+	Query_ListPostsByTag_FullMethodName         = "/blog.blog.Query/ListPostsByTag"
+	Query_ListTags_FullMethodName               = "/blog.blog.Query/ListTags"
+	Query_ListReactionsByPost_FullMethodName    = "/blog.blog.Query/ListReactionsByPost"
+	Query_ListReactionsByAccount_FullMethodName = "/blog.blog.Query/ListReactionsByAccount"
+	Query_ShowPostTips_FullMethodName           = "/blog.blog.Query/ShowPostTips"
+	Query_TopTippedPosts_FullMethodName         = "/blog.blog.Query/TopTippedPosts"
+	Query_ListPostsByEditor_FullMethodName      = "/blog.blog.Query/ListPostsByEditor"
+	Query_ListReports_FullMethodName            = "/blog.blog.Query/ListReports"
+	Query_ListReviewQueue_FullMethodName        = "/blog.blog.Query/ListReviewQueue"
+	Query_ListAnnouncements_FullMethodName      = "/blog.blog.Query/ListAnnouncements"
+	Query_ListFeaturedPosts_FullMethodName      = "/blog.blog.Query/ListFeaturedPosts"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +50,38 @@ type QueryClient interface {
 	ShowPost(ctx context.Context, in *QueryShowPostRequest, opts ...grpc.CallOption) (*QueryShowPostResponse, error)
 	// Queries a list of ListPost items.
 	ListPost(ctx context.Context, in *QueryListPostRequest, opts ...grpc.CallOption) (*QueryListPostResponse, error)
+	// Queries a list of ListPostRevisions items.
+	ListPostRevisions(ctx context.Context, in *QueryListPostRevisionsRequest, opts ...grpc.CallOption) (*QueryListPostRevisionsResponse, error)
+	// Queries a list of ShowPostRevision items.
+	ShowPostRevision(ctx context.Context, in *QueryShowPostRevisionRequest, opts ...grpc.CallOption) (*QueryShowPostRevisionResponse, error)
+	// Queries a list of ListCommentsByPost items.
+	ListCommentsByPost(ctx context.Context, in *QueryListCommentsByPostRequest, opts ...grpc.CallOption) (*QueryListCommentsByPostResponse, error)
+	// Queries a CommentThread rooted at a comment.
+	CommentThread(ctx context.Context, in *QueryCommentThreadRequest, opts ...grpc.CallOption) (*QueryCommentThreadResponse, error)
+	// Queries a list of ListPostsByCreator items.
+	ListPostsByCreator(ctx context.Context, in *QueryListPostsByCreatorRequest, opts ...grpc.CallOption) (*QueryListPostsByCreatorResponse, error)
+	// Queries a list of ListPostsByTag items.
+	ListPostsByTag(ctx context.Context, in *QueryListPostsByTagRequest, opts ...grpc.CallOption) (*QueryListPostsByTagResponse, error)
+	// Queries a list of ListTags items.
+	ListTags(ctx context.Context, in *QueryListTagsRequest, opts ...grpc.CallOption) (*QueryListTagsResponse, error)
+	// Queries a list of ListReactionsByPost items.
+	ListReactionsByPost(ctx context.Context, in *QueryListReactionsByPostRequest, opts ...grpc.CallOption) (*QueryListReactionsByPostResponse, error)
+	// Queries a list of ListReactionsByAccount items.
+	ListReactionsByAccount(ctx context.Context, in *QueryListReactionsByAccountRequest, opts ...grpc.CallOption) (*QueryListReactionsByAccountResponse, error)
+	// Queries the tips sent to a post and to its author.
+	ShowPostTips(ctx context.Context, in *QueryShowPostTipsRequest, opts ...grpc.CallOption) (*QueryShowPostTipsResponse, error)
+	// Queries the posts that received the most tips in a denom.
+	TopTippedPosts(ctx context.Context, in *QueryTopTippedPostsRequest, opts ...grpc.CallOption) (*QueryTopTippedPostsResponse, error)
+	// Queries the posts an account is an editor of.
+	ListPostsByEditor(ctx context.Context, in *QueryListPostsByEditorRequest, opts ...grpc.CallOption) (*QueryListPostsByEditorResponse, error)
+	// Queries the reports filed against a post.
+	ListReports(ctx context.Context, in *QueryListReportsRequest, opts ...grpc.CallOption) (*QueryListReportsResponse, error)
+	// Queries the posts hidden by reports and awaiting review.
+	ListReviewQueue(ctx context.Context, in *QueryListReviewQueueRequest, opts ...grpc.CallOption) (*QueryListReviewQueueResponse, error)
+	// Queries the official announcements created by governance.
+	ListAnnouncements(ctx context.Context, in *QueryListAnnouncementsRequest, opts ...grpc.CallOption) (*QueryListAnnouncementsResponse, error)
+	// Queries the posts featured by governance, in order.
+	ListFeaturedPosts(ctx context.Context, in *QueryListFeaturedPostsRequest, opts ...grpc.CallOption) (*QueryListFeaturedPostsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +119,150 @@ func (c *queryClient) ListPost(ctx context.Context, in *QueryListPostRequest, op
 	return out, nil
 }
 
+func (c *queryClient) ListPostRevisions(ctx context.Context, in *QueryListPostRevisionsRequest, opts ...grpc.CallOption) (*QueryListPostRevisionsResponse, error) {
+	out := new(QueryListPostRevisionsResponse)
+	err := c.cc.Invoke(ctx, Query_ListPostRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ShowPostRevision(ctx context.Context, in *QueryShowPostRevisionRequest, opts ...grpc.CallOption) (*QueryShowPostRevisionResponse, error) {
+	out := new(QueryShowPostRevisionResponse)
+	err := c.cc.Invoke(ctx, Query_ShowPostRevision_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListCommentsByPost(ctx context.Context, in *QueryListCommentsByPostRequest, opts ...grpc.CallOption) (*QueryListCommentsByPostResponse, error) {
+	out := new(QueryListCommentsByPostResponse)
+	err := c.cc.Invoke(ctx, Query_ListCommentsByPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommentThread(ctx context.Context, in *QueryCommentThreadRequest, opts ...grpc.CallOption) (*QueryCommentThreadResponse, error) {
+	out := new(QueryCommentThreadResponse)
+	err := c.cc.Invoke(ctx, Query_CommentThread_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPostsByCreator(ctx context.Context, in *QueryListPostsByCreatorRequest, opts ...grpc.CallOption) (*QueryListPostsByCreatorResponse, error) {
+	out := new(QueryListPostsByCreatorResponse)
+	err := c.cc.Invoke(ctx, Query_ListPostsByCreator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPostsByTag(ctx context.Context, in *QueryListPostsByTagRequest, opts ...grpc.CallOption) (*QueryListPostsByTagResponse, error) {
+	out := new(QueryListPostsByTagResponse)
+	err := c.cc.Invoke(ctx, Query_ListPostsByTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListTags(ctx context.Context, in *QueryListTagsRequest, opts ...grpc.CallOption) (*QueryListTagsResponse, error) {
+	out := new(QueryListTagsResponse)
+	err := c.cc.Invoke(ctx, Query_ListTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListReactionsByPost(ctx context.Context, in *QueryListReactionsByPostRequest, opts ...grpc.CallOption) (*QueryListReactionsByPostResponse, error) {
+	out := new(QueryListReactionsByPostResponse)
+	err := c.cc.Invoke(ctx, Query_ListReactionsByPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListReactionsByAccount(ctx context.Context, in *QueryListReactionsByAccountRequest, opts ...grpc.CallOption) (*QueryListReactionsByAccountResponse, error) {
+	out := new(QueryListReactionsByAccountResponse)
+	err := c.cc.Invoke(ctx, Query_ListReactionsByAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ShowPostTips(ctx context.Context, in *QueryShowPostTipsRequest, opts ...grpc.CallOption) (*QueryShowPostTipsResponse, error) {
+	out := new(QueryShowPostTipsResponse)
+	err := c.cc.Invoke(ctx, Query_ShowPostTips_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TopTippedPosts(ctx context.Context, in *QueryTopTippedPostsRequest, opts ...grpc.CallOption) (*QueryTopTippedPostsResponse, error) {
+	out := new(QueryTopTippedPostsResponse)
+	err := c.cc.Invoke(ctx, Query_TopTippedPosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPostsByEditor(ctx context.Context, in *QueryListPostsByEditorRequest, opts ...grpc.CallOption) (*QueryListPostsByEditorResponse, error) {
+	out := new(QueryListPostsByEditorResponse)
+	err := c.cc.Invoke(ctx, Query_ListPostsByEditor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListReports(ctx context.Context, in *QueryListReportsRequest, opts ...grpc.CallOption) (*QueryListReportsResponse, error) {
+	out := new(QueryListReportsResponse)
+	err := c.cc.Invoke(ctx, Query_ListReports_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListReviewQueue(ctx context.Context, in *QueryListReviewQueueRequest, opts ...grpc.CallOption) (*QueryListReviewQueueResponse, error) {
+	out := new(QueryListReviewQueueResponse)
+	err := c.cc.Invoke(ctx, Query_ListReviewQueue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAnnouncements(ctx context.Context, in *QueryListAnnouncementsRequest, opts ...grpc.CallOption) (*QueryListAnnouncementsResponse, error) {
+	out := new(QueryListAnnouncementsResponse)
+	err := c.cc.Invoke(ctx, Query_ListAnnouncements_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListFeaturedPosts(ctx context.Context, in *QueryListFeaturedPostsRequest, opts ...grpc.CallOption) (*QueryListFeaturedPostsResponse, error) {
+	out := new(QueryListFeaturedPostsResponse)
+	err := c.cc.Invoke(ctx, Query_ListFeaturedPosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +273,38 @@ type QueryServer interface {
 	ShowPost(context.Context, *QueryShowPostRequest) (*QueryShowPostResponse, error)
 	// Queries a list of ListPost items.
 	ListPost(context.Context, *QueryListPostRequest) (*QueryListPostResponse, error)
+	// Queries a list of ListPostRevisions items.
+	ListPostRevisions(context.Context, *QueryListPostRevisionsRequest) (*QueryListPostRevisionsResponse, error)
+	// Queries a list of ShowPostRevision items.
+	ShowPostRevision(context.Context, *QueryShowPostRevisionRequest) (*QueryShowPostRevisionResponse, error)
+	// Queries a list of ListCommentsByPost items.
+	ListCommentsByPost(context.Context, *QueryListCommentsByPostRequest) (*QueryListCommentsByPostResponse, error)
+	// Queries a CommentThread rooted at a comment.
+	CommentThread(context.Context, *QueryCommentThreadRequest) (*QueryCommentThreadResponse, error)
+	// Queries a list of ListPostsByCreator items.
+	ListPostsByCreator(context.Context, *QueryListPostsByCreatorRequest) (*QueryListPostsByCreatorResponse, error)
+	// Queries a list of ListPostsByTag items.
+	ListPostsByTag(context.Context, *QueryListPostsByTagRequest) (*QueryListPostsByTagResponse, error)
+	// Queries a list of ListTags items.
+	ListTags(context.Context, *QueryListTagsRequest) (*QueryListTagsResponse, error)
+	// Queries a list of ListReactionsByPost items.
+	ListReactionsByPost(context.Context, *QueryListReactionsByPostRequest) (*QueryListReactionsByPostResponse, error)
+	// Queries a list of ListReactionsByAccount items.
+	ListReactionsByAccount(context.Context, *QueryListReactionsByAccountRequest) (*QueryListReactionsByAccountResponse, error)
+	// Queries the tips sent to a post and to its author.
+	ShowPostTips(context.Context, *QueryShowPostTipsRequest) (*QueryShowPostTipsResponse, error)
+	// Queries the posts that received the most tips in a denom.
+	TopTippedPosts(context.Context, *QueryTopTippedPostsRequest) (*QueryTopTippedPostsResponse, error)
+	// Queries the posts an account is an editor of.
+	ListPostsByEditor(context.Context, *QueryListPostsByEditorRequest) (*QueryListPostsByEditorResponse, error)
+	// Queries the reports filed against a post.
+	ListReports(context.Context, *QueryListReportsRequest) (*QueryListReportsResponse, error)
+	// Queries the posts hidden by reports and awaiting review.
+	ListReviewQueue(context.Context, *QueryListReviewQueueRequest) (*QueryListReviewQueueResponse, error)
+	// Queries the official announcements created by governance.
+	ListAnnouncements(context.Context, *QueryListAnnouncementsRequest) (*QueryListAnnouncementsResponse, error)
+	// Queries the posts featured by governance, in order.
+	ListFeaturedPosts(context.Context, *QueryListFeaturedPostsRequest) (*QueryListFeaturedPostsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +321,54 @@ func (UnimplementedQueryServer) ShowPost(context.Context, *QueryShowPostRequest)
 func (UnimplementedQueryServer) ListPost(context.Context, *QueryListPostRequest) (*QueryListPostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListPost not implemented")
 }
+func (UnimplementedQueryServer) ListPostRevisions(context.Context, *QueryListPostRevisionsRequest) (*QueryListPostRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostRevisions not implemented")
+}
+func (UnimplementedQueryServer) ShowPostRevision(context.Context, *QueryShowPostRevisionRequest) (*QueryShowPostRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowPostRevision not implemented")
+}
+func (UnimplementedQueryServer) ListCommentsByPost(context.Context, *QueryListCommentsByPostRequest) (*QueryListCommentsByPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCommentsByPost not implemented")
+}
+func (UnimplementedQueryServer) CommentThread(context.Context, *QueryCommentThreadRequest) (*QueryCommentThreadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommentThread not implemented")
+}
+func (UnimplementedQueryServer) ListPostsByCreator(context.Context, *QueryListPostsByCreatorRequest) (*QueryListPostsByCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostsByCreator not implemented")
+}
+func (UnimplementedQueryServer) ListPostsByTag(context.Context, *QueryListPostsByTagRequest) (*QueryListPostsByTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostsByTag not implemented")
+}
+func (UnimplementedQueryServer) ListTags(context.Context, *QueryListTagsRequest) (*QueryListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedQueryServer) ListReactionsByPost(context.Context, *QueryListReactionsByPostRequest) (*QueryListReactionsByPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReactionsByPost not implemented")
+}
+func (UnimplementedQueryServer) ListReactionsByAccount(context.Context, *QueryListReactionsByAccountRequest) (*QueryListReactionsByAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReactionsByAccount not implemented")
+}
+func (UnimplementedQueryServer) ShowPostTips(context.Context, *QueryShowPostTipsRequest) (*QueryShowPostTipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowPostTips not implemented")
+}
+func (UnimplementedQueryServer) TopTippedPosts(context.Context, *QueryTopTippedPostsRequest) (*QueryTopTippedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopTippedPosts not implemented")
+}
+func (UnimplementedQueryServer) ListPostsByEditor(context.Context, *QueryListPostsByEditorRequest) (*QueryListPostsByEditorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPostsByEditor not implemented")
+}
+func (UnimplementedQueryServer) ListReports(context.Context, *QueryListReportsRequest) (*QueryListReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReports not implemented")
+}
+func (UnimplementedQueryServer) ListReviewQueue(context.Context, *QueryListReviewQueueRequest) (*QueryListReviewQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewQueue not implemented")
+}
+func (UnimplementedQueryServer) ListAnnouncements(context.Context, *QueryListAnnouncementsRequest) (*QueryListAnnouncementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAnnouncements not implemented")
+}
+func (UnimplementedQueryServer) ListFeaturedPosts(context.Context, *QueryListFeaturedPostsRequest) (*QueryListFeaturedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeaturedPosts not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +436,294 @@ func _Query_ListPost_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ListPostRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPostRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPostRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListPostRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPostRevisions(ctx, req.(*QueryListPostRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ShowPostRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShowPostRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ShowPostRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ShowPostRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShowPostRevision(ctx, req.(*QueryShowPostRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListCommentsByPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListCommentsByPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListCommentsByPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListCommentsByPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListCommentsByPost(ctx, req.(*QueryListCommentsByPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommentThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommentThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommentThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CommentThread_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommentThread(ctx, req.(*QueryCommentThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPostsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPostsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPostsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListPostsByCreator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPostsByCreator(ctx, req.(*QueryListPostsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPostsByTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPostsByTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPostsByTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListPostsByTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPostsByTag(ctx, req.(*QueryListPostsByTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTags(ctx, req.(*QueryListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListReactionsByPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListReactionsByPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListReactionsByPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListReactionsByPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListReactionsByPost(ctx, req.(*QueryListReactionsByPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListReactionsByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListReactionsByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListReactionsByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListReactionsByAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListReactionsByAccount(ctx, req.(*QueryListReactionsByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ShowPostTips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryShowPostTipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ShowPostTips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ShowPostTips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ShowPostTips(ctx, req.(*QueryShowPostTipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TopTippedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTopTippedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TopTippedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TopTippedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TopTippedPosts(ctx, req.(*QueryTopTippedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPostsByEditor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListPostsByEditorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPostsByEditor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListPostsByEditor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPostsByEditor(ctx, req.(*QueryListPostsByEditorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListReports(ctx, req.(*QueryListReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListReviewQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListReviewQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListReviewQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListReviewQueue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListReviewQueue(ctx, req.(*QueryListReviewQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAnnouncements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListAnnouncementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAnnouncements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListAnnouncements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAnnouncements(ctx, req.(*QueryListAnnouncementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListFeaturedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListFeaturedPostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListFeaturedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ListFeaturedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListFeaturedPosts(ctx, req.(*QueryListFeaturedPostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +743,70 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListPost",
 			Handler:    _Query_ListPost_Handler,
 		},
+		{
+			MethodName: "ListPostRevisions",
+			Handler:    _Query_ListPostRevisions_Handler,
+		},
+		{
+			MethodName: "ShowPostRevision",
+			Handler:    _Query_ShowPostRevision_Handler,
+		},
+		{
+			MethodName: "ListCommentsByPost",
+			Handler:    _Query_ListCommentsByPost_Handler,
+		},
+		{
+			MethodName: "CommentThread",
+			Handler:    _Query_CommentThread_Handler,
+		},
+		{
+			MethodName: "ListPostsByCreator",
+			Handler:    _Query_ListPostsByCreator_Handler,
+		},
+		{
+			MethodName: "ListPostsByTag",
+			Handler:    _Query_ListPostsByTag_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _Query_ListTags_Handler,
+		},
+		{
+			MethodName: "ListReactionsByPost",
+			Handler:    _Query_ListReactionsByPost_Handler,
+		},
+		{
+			MethodName: "ListReactionsByAccount",
+			Handler:    _Query_ListReactionsByAccount_Handler,
+		},
+		{
+			MethodName: "ShowPostTips",
+			Handler:    _Query_ShowPostTips_Handler,
+		},
+		{
+			MethodName: "TopTippedPosts",
+			Handler:    _Query_TopTippedPosts_Handler,
+		},
+		{
+			MethodName: "ListPostsByEditor",
+			Handler:    _Query_ListPostsByEditor_Handler,
+		},
+		{
+			MethodName: "ListReports",
+			Handler:    _Query_ListReports_Handler,
+		},
+		{
+			MethodName: "ListReviewQueue",
+			Handler:    _Query_ListReviewQueue_Handler,
+		},
+		{
+			MethodName: "ListAnnouncements",
+			Handler:    _Query_ListAnnouncements_Handler,
+		},
+		{
+			MethodName: "ListFeaturedPosts",
+			Handler:    _Query_ListFeaturedPosts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blog/blog/query.proto",
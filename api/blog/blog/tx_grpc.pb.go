@@ -19,10 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateParams_FullMethodName = "/blog.blog.Msg/UpdateParams"
-	Msg_CreatePost_FullMethodName   = "/blog.blog.Msg/CreatePost"
-	Msg_UpdatePost_FullMethodName   = "/blog.blog.Msg/UpdatePost"
-	Msg_DeletePost_FullMethodName   = "/blog.blog.Msg/DeletePost"
+	Msg_UpdateParams_FullMethodName       = "/blog.blog.Msg/UpdateParams"
+	Msg_CreatePost_FullMethodName         = "/blog.blog.Msg/CreatePost"
+	Msg_UpdatePost_FullMethodName         = "/blog.blog.Msg/UpdatePost"
+	Msg_DeletePost_FullMethodName         = "/blog.blog.Msg/DeletePost"
+	Msg_RestorePost_FullMethodName        = "/blog.blog.Msg/RestorePost"
+	Msg_CreateComment_FullMethodName      = "/blog.blog.Msg/CreateComment"
+	Msg_UpdateComment_FullMethodName      = "/blog.blog.Msg/UpdateComment"
+	Msg_DeleteComment_FullMethodName      = "/blog.blog.Msg/DeleteComment"
+	Msg_ReplyComment_FullMethodName       = "/blog.blog.Msg/ReplyComment"
+	Msg_ReactToPost_FullMethodName        = "/blog.blog.Msg/ReactToPost"
+	Msg_RemoveReaction_FullMethodName     = "/blog.blog.Msg/RemoveReaction"
+	Msg_PublishPost_FullMethodName        = "/blog.blog.Msg/PublishPost"
+	Msg_TipPost_FullMethodName            = "/blog.blog.Msg/TipPost"
+	Msg_TransferPost_FullMethodName       = "/blog.blog.Msg/TransferPost"
+	Msg_AcceptPostTransfer_FullMethodName = "/blog.blog.Msg/AcceptPostTransfer"
+	Msg_MigrateAuthor_FullMethodName      = "/blog.blog.Msg/MigrateAuthor"
+	Msg_AddEditor_FullMethodName          = "/blog.blog.Msg/AddEditor"
+	Msg_RemoveEditor_FullMethodName       = "/blog.blog.Msg/RemoveEditor"
+	Msg_HidePost_FullMethodName           = "/blog.blog.Msg/HidePost"
+	Msg_UnhidePost_FullMethodName         = "/blog.blog.Msg/UnhidePost"
+	Msg_ReportPost_FullMethodName         = "/blog.blog.Msg/ReportPost"
+	Msg_ReviewPost_FullMethodName         = "/blog.blog.Msg/ReviewPost"
+	Msg_UpdateBlocklist_FullMethodName    = "/blog.blog.Msg/UpdateBlocklist"
+	Msg_CreateAnnouncement_FullMethodName = "/blog.blog.Msg/CreateAnnouncement"
+	Msg_SetFeaturedPosts_FullMethodName   = "/blog.blog.Msg/SetFeaturedPosts"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +56,27 @@ type MsgClient interface {
 	CreatePost(ctx context.Context, in *MsgCreatePost, opts ...grpc.CallOption) (*MsgCreatePostResponse, error)
 	UpdatePost(ctx context.Context, in *MsgUpdatePost, opts ...grpc.CallOption) (*MsgUpdatePostResponse, error)
 	DeletePost(ctx context.Context, in *MsgDeletePost, opts ...grpc.CallOption) (*MsgDeletePostResponse, error)
+	RestorePost(ctx context.Context, in *MsgRestorePost, opts ...grpc.CallOption) (*MsgRestorePostResponse, error)
+	CreateComment(ctx context.Context, in *MsgCreateComment, opts ...grpc.CallOption) (*MsgCreateCommentResponse, error)
+	UpdateComment(ctx context.Context, in *MsgUpdateComment, opts ...grpc.CallOption) (*MsgUpdateCommentResponse, error)
+	DeleteComment(ctx context.Context, in *MsgDeleteComment, opts ...grpc.CallOption) (*MsgDeleteCommentResponse, error)
+	ReplyComment(ctx context.Context, in *MsgReplyComment, opts ...grpc.CallOption) (*MsgReplyCommentResponse, error)
+	ReactToPost(ctx context.Context, in *MsgReactToPost, opts ...grpc.CallOption) (*MsgReactToPostResponse, error)
+	RemoveReaction(ctx context.Context, in *MsgRemoveReaction, opts ...grpc.CallOption) (*MsgRemoveReactionResponse, error)
+	PublishPost(ctx context.Context, in *MsgPublishPost, opts ...grpc.CallOption) (*MsgPublishPostResponse, error)
+	TipPost(ctx context.Context, in *MsgTipPost, opts ...grpc.CallOption) (*MsgTipPostResponse, error)
+	TransferPost(ctx context.Context, in *MsgTransferPost, opts ...grpc.CallOption) (*MsgTransferPostResponse, error)
+	AcceptPostTransfer(ctx context.Context, in *MsgAcceptPostTransfer, opts ...grpc.CallOption) (*MsgAcceptPostTransferResponse, error)
+	MigrateAuthor(ctx context.Context, in *MsgMigrateAuthor, opts ...grpc.CallOption) (*MsgMigrateAuthorResponse, error)
+	AddEditor(ctx context.Context, in *MsgAddEditor, opts ...grpc.CallOption) (*MsgAddEditorResponse, error)
+	RemoveEditor(ctx context.Context, in *MsgRemoveEditor, opts ...grpc.CallOption) (*MsgRemoveEditorResponse, error)
+	HidePost(ctx context.Context, in *MsgHidePost, opts ...grpc.CallOption) (*MsgHidePostResponse, error)
+	UnhidePost(ctx context.Context, in *MsgUnhidePost, opts ...grpc.CallOption) (*MsgUnhidePostResponse, error)
+	ReportPost(ctx context.Context, in *MsgReportPost, opts ...grpc.CallOption) (*MsgReportPostResponse, error)
+	ReviewPost(ctx context.Context, in *MsgReviewPost, opts ...grpc.CallOption) (*MsgReviewPostResponse, error)
+	UpdateBlocklist(ctx context.Context, in *MsgUpdateBlocklist, opts ...grpc.CallOption) (*MsgUpdateBlocklistResponse, error)
+	CreateAnnouncement(ctx context.Context, in *MsgCreateAnnouncement, opts ...grpc.CallOption) (*MsgCreateAnnouncementResponse, error)
+	SetFeaturedPosts(ctx context.Context, in *MsgSetFeaturedPosts, opts ...grpc.CallOption) (*MsgSetFeaturedPostsResponse, error)
 }
 
 type msgClient struct {
@@ -81,6 +123,195 @@ func (c *msgClient) DeletePost(ctx context.Context, in *MsgDeletePost, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) RestorePost(ctx context.Context, in *MsgRestorePost, opts ...grpc.CallOption) (*MsgRestorePostResponse, error) {
+	out := new(MsgRestorePostResponse)
+	err := c.cc.Invoke(ctx, Msg_RestorePost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateComment(ctx context.Context, in *MsgCreateComment, opts ...grpc.CallOption) (*MsgCreateCommentResponse, error) {
+	out := new(MsgCreateCommentResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateComment(ctx context.Context, in *MsgUpdateComment, opts ...grpc.CallOption) (*MsgUpdateCommentResponse, error) {
+	out := new(MsgUpdateCommentResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteComment(ctx context.Context, in *MsgDeleteComment, opts ...grpc.CallOption) (*MsgDeleteCommentResponse, error) {
+	out := new(MsgDeleteCommentResponse)
+	err := c.cc.Invoke(ctx, Msg_DeleteComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReplyComment(ctx context.Context, in *MsgReplyComment, opts ...grpc.CallOption) (*MsgReplyCommentResponse, error) {
+	out := new(MsgReplyCommentResponse)
+	err := c.cc.Invoke(ctx, Msg_ReplyComment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReactToPost(ctx context.Context, in *MsgReactToPost, opts ...grpc.CallOption) (*MsgReactToPostResponse, error) {
+	out := new(MsgReactToPostResponse)
+	err := c.cc.Invoke(ctx, Msg_ReactToPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveReaction(ctx context.Context, in *MsgRemoveReaction, opts ...grpc.CallOption) (*MsgRemoveReactionResponse, error) {
+	out := new(MsgRemoveReactionResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveReaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PublishPost(ctx context.Context, in *MsgPublishPost, opts ...grpc.CallOption) (*MsgPublishPostResponse, error) {
+	out := new(MsgPublishPostResponse)
+	err := c.cc.Invoke(ctx, Msg_PublishPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TipPost(ctx context.Context, in *MsgTipPost, opts ...grpc.CallOption) (*MsgTipPostResponse, error) {
+	out := new(MsgTipPostResponse)
+	err := c.cc.Invoke(ctx, Msg_TipPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferPost(ctx context.Context, in *MsgTransferPost, opts ...grpc.CallOption) (*MsgTransferPostResponse, error) {
+	out := new(MsgTransferPostResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptPostTransfer(ctx context.Context, in *MsgAcceptPostTransfer, opts ...grpc.CallOption) (*MsgAcceptPostTransferResponse, error) {
+	out := new(MsgAcceptPostTransferResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptPostTransfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateAuthor(ctx context.Context, in *MsgMigrateAuthor, opts ...grpc.CallOption) (*MsgMigrateAuthorResponse, error) {
+	out := new(MsgMigrateAuthorResponse)
+	err := c.cc.Invoke(ctx, Msg_MigrateAuthor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddEditor(ctx context.Context, in *MsgAddEditor, opts ...grpc.CallOption) (*MsgAddEditorResponse, error) {
+	out := new(MsgAddEditorResponse)
+	err := c.cc.Invoke(ctx, Msg_AddEditor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveEditor(ctx context.Context, in *MsgRemoveEditor, opts ...grpc.CallOption) (*MsgRemoveEditorResponse, error) {
+	out := new(MsgRemoveEditorResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveEditor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) HidePost(ctx context.Context, in *MsgHidePost, opts ...grpc.CallOption) (*MsgHidePostResponse, error) {
+	out := new(MsgHidePostResponse)
+	err := c.cc.Invoke(ctx, Msg_HidePost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnhidePost(ctx context.Context, in *MsgUnhidePost, opts ...grpc.CallOption) (*MsgUnhidePostResponse, error) {
+	out := new(MsgUnhidePostResponse)
+	err := c.cc.Invoke(ctx, Msg_UnhidePost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReportPost(ctx context.Context, in *MsgReportPost, opts ...grpc.CallOption) (*MsgReportPostResponse, error) {
+	out := new(MsgReportPostResponse)
+	err := c.cc.Invoke(ctx, Msg_ReportPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReviewPost(ctx context.Context, in *MsgReviewPost, opts ...grpc.CallOption) (*MsgReviewPostResponse, error) {
+	out := new(MsgReviewPostResponse)
+	err := c.cc.Invoke(ctx, Msg_ReviewPost_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBlocklist(ctx context.Context, in *MsgUpdateBlocklist, opts ...grpc.CallOption) (*MsgUpdateBlocklistResponse, error) {
+	out := new(MsgUpdateBlocklistResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateBlocklist_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateAnnouncement(ctx context.Context, in *MsgCreateAnnouncement, opts ...grpc.CallOption) (*MsgCreateAnnouncementResponse, error) {
+	out := new(MsgCreateAnnouncementResponse)
+	err := c.cc.Invoke(ctx, Msg_CreateAnnouncement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeaturedPosts(ctx context.Context, in *MsgSetFeaturedPosts, opts ...grpc.CallOption) (*MsgSetFeaturedPostsResponse, error) {
+	out := new(MsgSetFeaturedPostsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeaturedPosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -91,6 +322,27 @@ type MsgServer interface {
 	CreatePost(context.Context, *MsgCreatePost) (*MsgCreatePostResponse, error)
 	UpdatePost(context.Context, *MsgUpdatePost) (*MsgUpdatePostResponse, error)
 	DeletePost(context.Context, *MsgDeletePost) (*MsgDeletePostResponse, error)
+	RestorePost(context.Context, *MsgRestorePost) (*MsgRestorePostResponse, error)
+	CreateComment(context.Context, *MsgCreateComment) (*MsgCreateCommentResponse, error)
+	UpdateComment(context.Context, *MsgUpdateComment) (*MsgUpdateCommentResponse, error)
+	DeleteComment(context.Context, *MsgDeleteComment) (*MsgDeleteCommentResponse, error)
+	ReplyComment(context.Context, *MsgReplyComment) (*MsgReplyCommentResponse, error)
+	ReactToPost(context.Context, *MsgReactToPost) (*MsgReactToPostResponse, error)
+	RemoveReaction(context.Context, *MsgRemoveReaction) (*MsgRemoveReactionResponse, error)
+	PublishPost(context.Context, *MsgPublishPost) (*MsgPublishPostResponse, error)
+	TipPost(context.Context, *MsgTipPost) (*MsgTipPostResponse, error)
+	TransferPost(context.Context, *MsgTransferPost) (*MsgTransferPostResponse, error)
+	AcceptPostTransfer(context.Context, *MsgAcceptPostTransfer) (*MsgAcceptPostTransferResponse, error)
+	MigrateAuthor(context.Context, *MsgMigrateAuthor) (*MsgMigrateAuthorResponse, error)
+	AddEditor(context.Context, *MsgAddEditor) (*MsgAddEditorResponse, error)
+	RemoveEditor(context.Context, *MsgRemoveEditor) (*MsgRemoveEditorResponse, error)
+	HidePost(context.Context, *MsgHidePost) (*MsgHidePostResponse, error)
+	UnhidePost(context.Context, *MsgUnhidePost) (*MsgUnhidePostResponse, error)
+	ReportPost(context.Context, *MsgReportPost) (*MsgReportPostResponse, error)
+	ReviewPost(context.Context, *MsgReviewPost) (*MsgReviewPostResponse, error)
+	UpdateBlocklist(context.Context, *MsgUpdateBlocklist) (*MsgUpdateBlocklistResponse, error)
+	CreateAnnouncement(context.Context, *MsgCreateAnnouncement) (*MsgCreateAnnouncementResponse, error)
+	SetFeaturedPosts(context.Context, *MsgSetFeaturedPosts) (*MsgSetFeaturedPostsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -110,6 +362,69 @@ func (UnimplementedMsgServer) UpdatePost(context.Context, *MsgUpdatePost) (*MsgU
 func (UnimplementedMsgServer) DeletePost(context.Context, *MsgDeletePost) (*MsgDeletePostResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePost not implemented")
 }
+func (UnimplementedMsgServer) RestorePost(context.Context, *MsgRestorePost) (*MsgRestorePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestorePost not implemented")
+}
+func (UnimplementedMsgServer) CreateComment(context.Context, *MsgCreateComment) (*MsgCreateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateComment not implemented")
+}
+func (UnimplementedMsgServer) UpdateComment(context.Context, *MsgUpdateComment) (*MsgUpdateCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateComment not implemented")
+}
+func (UnimplementedMsgServer) DeleteComment(context.Context, *MsgDeleteComment) (*MsgDeleteCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteComment not implemented")
+}
+func (UnimplementedMsgServer) ReplyComment(context.Context, *MsgReplyComment) (*MsgReplyCommentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyComment not implemented")
+}
+func (UnimplementedMsgServer) ReactToPost(context.Context, *MsgReactToPost) (*MsgReactToPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactToPost not implemented")
+}
+func (UnimplementedMsgServer) RemoveReaction(context.Context, *MsgRemoveReaction) (*MsgRemoveReactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (UnimplementedMsgServer) PublishPost(context.Context, *MsgPublishPost) (*MsgPublishPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPost not implemented")
+}
+func (UnimplementedMsgServer) TipPost(context.Context, *MsgTipPost) (*MsgTipPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TipPost not implemented")
+}
+func (UnimplementedMsgServer) TransferPost(context.Context, *MsgTransferPost) (*MsgTransferPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferPost not implemented")
+}
+func (UnimplementedMsgServer) AcceptPostTransfer(context.Context, *MsgAcceptPostTransfer) (*MsgAcceptPostTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPostTransfer not implemented")
+}
+func (UnimplementedMsgServer) MigrateAuthor(context.Context, *MsgMigrateAuthor) (*MsgMigrateAuthorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateAuthor not implemented")
+}
+func (UnimplementedMsgServer) AddEditor(context.Context, *MsgAddEditor) (*MsgAddEditorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEditor not implemented")
+}
+func (UnimplementedMsgServer) RemoveEditor(context.Context, *MsgRemoveEditor) (*MsgRemoveEditorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEditor not implemented")
+}
+func (UnimplementedMsgServer) HidePost(context.Context, *MsgHidePost) (*MsgHidePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HidePost not implemented")
+}
+func (UnimplementedMsgServer) UnhidePost(context.Context, *MsgUnhidePost) (*MsgUnhidePostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnhidePost not implemented")
+}
+func (UnimplementedMsgServer) ReportPost(context.Context, *MsgReportPost) (*MsgReportPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportPost not implemented")
+}
+func (UnimplementedMsgServer) ReviewPost(context.Context, *MsgReviewPost) (*MsgReviewPostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewPost not implemented")
+}
+func (UnimplementedMsgServer) UpdateBlocklist(context.Context, *MsgUpdateBlocklist) (*MsgUpdateBlocklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlocklist not implemented")
+}
+func (UnimplementedMsgServer) CreateAnnouncement(context.Context, *MsgCreateAnnouncement) (*MsgCreateAnnouncementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAnnouncement not implemented")
+}
+func (UnimplementedMsgServer) SetFeaturedPosts(context.Context, *MsgSetFeaturedPosts) (*MsgSetFeaturedPostsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeaturedPosts not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -195,6 +510,384 @@ func _Msg_DeletePost_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RestorePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRestorePost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RestorePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RestorePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RestorePost(ctx, req.(*MsgRestorePost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateComment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateComment(ctx, req.(*MsgCreateComment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateComment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateComment(ctx, req.(*MsgUpdateComment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteComment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeleteComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteComment(ctx, req.(*MsgDeleteComment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReplyComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReplyComment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReplyComment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReplyComment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReplyComment(ctx, req.(*MsgReplyComment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReactToPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReactToPost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReactToPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReactToPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReactToPost(ctx, req.(*MsgReactToPost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveReaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveReaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveReaction(ctx, req.(*MsgRemoveReaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PublishPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPublishPost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PublishPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PublishPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PublishPost(ctx, req.(*MsgPublishPost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TipPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTipPost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TipPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TipPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TipPost(ctx, req.(*MsgTipPost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferPost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferPost(ctx, req.(*MsgTransferPost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptPostTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptPostTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptPostTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptPostTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptPostTransfer(ctx, req.(*MsgAcceptPostTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateAuthor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateAuthor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateAuthor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_MigrateAuthor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateAuthor(ctx, req.(*MsgMigrateAuthor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddEditor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddEditor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddEditor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddEditor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddEditor(ctx, req.(*MsgAddEditor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveEditor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveEditor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveEditor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveEditor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveEditor(ctx, req.(*MsgRemoveEditor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_HidePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgHidePost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).HidePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_HidePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).HidePost(ctx, req.(*MsgHidePost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnhidePost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnhidePost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnhidePost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UnhidePost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnhidePost(ctx, req.(*MsgUnhidePost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportPost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReportPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportPost(ctx, req.(*MsgReportPost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReviewPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReviewPost)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReviewPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ReviewPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReviewPost(ctx, req.(*MsgReviewPost))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBlocklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBlocklist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBlocklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateBlocklist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBlocklist(ctx, req.(*MsgUpdateBlocklist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAnnouncement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CreateAnnouncement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAnnouncement(ctx, req.(*MsgCreateAnnouncement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeaturedPosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeaturedPosts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeaturedPosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeaturedPosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeaturedPosts(ctx, req.(*MsgSetFeaturedPosts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -218,6 +911,90 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePost",
 			Handler:    _Msg_DeletePost_Handler,
 		},
+		{
+			MethodName: "RestorePost",
+			Handler:    _Msg_RestorePost_Handler,
+		},
+		{
+			MethodName: "CreateComment",
+			Handler:    _Msg_CreateComment_Handler,
+		},
+		{
+			MethodName: "UpdateComment",
+			Handler:    _Msg_UpdateComment_Handler,
+		},
+		{
+			MethodName: "DeleteComment",
+			Handler:    _Msg_DeleteComment_Handler,
+		},
+		{
+			MethodName: "ReplyComment",
+			Handler:    _Msg_ReplyComment_Handler,
+		},
+		{
+			MethodName: "ReactToPost",
+			Handler:    _Msg_ReactToPost_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _Msg_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "PublishPost",
+			Handler:    _Msg_PublishPost_Handler,
+		},
+		{
+			MethodName: "TipPost",
+			Handler:    _Msg_TipPost_Handler,
+		},
+		{
+			MethodName: "TransferPost",
+			Handler:    _Msg_TransferPost_Handler,
+		},
+		{
+			MethodName: "AcceptPostTransfer",
+			Handler:    _Msg_AcceptPostTransfer_Handler,
+		},
+		{
+			MethodName: "MigrateAuthor",
+			Handler:    _Msg_MigrateAuthor_Handler,
+		},
+		{
+			MethodName: "AddEditor",
+			Handler:    _Msg_AddEditor_Handler,
+		},
+		{
+			MethodName: "RemoveEditor",
+			Handler:    _Msg_RemoveEditor_Handler,
+		},
+		{
+			MethodName: "HidePost",
+			Handler:    _Msg_HidePost_Handler,
+		},
+		{
+			MethodName: "UnhidePost",
+			Handler:    _Msg_UnhidePost_Handler,
+		},
+		{
+			MethodName: "ReportPost",
+			Handler:    _Msg_ReportPost_Handler,
+		},
+		{
+			MethodName: "ReviewPost",
+			Handler:    _Msg_ReviewPost_Handler,
+		},
+		{
+			MethodName: "UpdateBlocklist",
+			Handler:    _Msg_UpdateBlocklist_Handler,
+		},
+		{
+			MethodName: "CreateAnnouncement",
+			Handler:    _Msg_CreateAnnouncement_Handler,
+		},
+		{
+			MethodName: "SetFeaturedPosts",
+			Handler:    _Msg_SetFeaturedPosts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "blog/blog/tx.proto",
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MockBankKeeper is an in-memory implementation of the blog BankKeeper used
+// by keeper tests.
+type MockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func NewMockBankKeeper() *MockBankKeeper {
+	return &MockBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+// Fund adds coins to the balance of an account.
+func (b *MockBankKeeper) Fund(addr sdk.AccAddress, amt sdk.Coins) {
+	b.balances[addr.String()] = b.balances[addr.String()].Add(amt...)
+}
+
+// ModuleBalance returns the balance of a module account.
+func (b *MockBankKeeper) ModuleBalance(moduleName string) sdk.Coins {
+	return b.balances[authtypes.NewModuleAddress(moduleName).String()]
+}
+
+func (b *MockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *MockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *MockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b *MockBankKeeper) SendCoins(_ context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(fromAddr, toAddr, amt)
+}
+
+func (b *MockBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
+	balance, negative := b.balances[from.String()].SafeSub(amt...)
+	if negative {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", b.balances[from.String()], amt)
+	}
+	b.balances[from.String()] = balance
+	b.balances[to.String()] = b.balances[to.String()].Add(amt...)
+	return nil
+}
@@ -22,6 +22,19 @@ import (
 )
 
 func BlogKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
+	k, _, ctx := BlogKeeperWithBank(t)
+	return k, ctx
+}
+
+// BlogKeeperWithBank returns a blog keeper backed by an in-memory bank keeper.
+func BlogKeeperWithBank(t testing.TB) (keeper.Keeper, *MockBankKeeper, sdk.Context) {
+	k, bankKeeper, _, ctx := BlogKeeperWithMocks(t)
+	return k, bankKeeper, ctx
+}
+
+// BlogKeeperWithMocks returns a blog keeper backed by in-memory bank and nft
+// keepers, with the post NFT class already created.
+func BlogKeeperWithMocks(t testing.TB) (keeper.Keeper, *MockBankKeeper, *MockNFTKeeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	db := dbm.NewMemDB()
@@ -32,12 +45,16 @@ func BlogKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	bankKeeper := NewMockBankKeeper()
+	nftKeeper := NewMockNFTKeeper()
 
 	k := keeper.NewKeeper(
 		cdc,
 		runtime.NewKVStoreService(storeKey),
 		log.NewNopLogger(),
 		authority.String(),
+		bankKeeper,
+		nftKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, log.NewNopLogger())
@@ -46,6 +63,9 @@ func BlogKeeper(t testing.TB) (keeper.Keeper, sdk.Context) {
 	if err := k.SetParams(ctx, types.DefaultParams()); err != nil {
 		panic(err)
 	}
+	if err := k.InitPostNFTClass(ctx); err != nil {
+		panic(err)
+	}
 
-	return k, ctx
+	return k, bankKeeper, nftKeeper, ctx
 }
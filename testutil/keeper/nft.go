@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MockNFTKeeper is an in-memory implementation of the blog NFTKeeper used
+// by keeper tests.
+type MockNFTKeeper struct {
+	classes  map[string]nft.Class
+	owners   map[string]sdk.AccAddress
+	failures map[string]error
+}
+
+func NewMockNFTKeeper() *MockNFTKeeper {
+	return &MockNFTKeeper{
+		classes:  make(map[string]nft.Class),
+		owners:   make(map[string]sdk.AccAddress),
+		failures: make(map[string]error),
+	}
+}
+
+// FailTransfer makes every later transfer of an NFT return err.
+func (n *MockNFTKeeper) FailTransfer(classID, nftID string, err error) {
+	n.failures[classID+"/"+nftID] = err
+}
+
+func (n *MockNFTKeeper) SaveClass(_ context.Context, class nft.Class) error {
+	if _, ok := n.classes[class.Id]; ok {
+		return errorsmod.Wrap(nft.ErrClassExists, class.Id)
+	}
+	n.classes[class.Id] = class
+	return nil
+}
+
+func (n *MockNFTKeeper) HasClass(_ context.Context, classID string) bool {
+	_, ok := n.classes[classID]
+	return ok
+}
+
+func (n *MockNFTKeeper) Mint(ctx context.Context, token nft.NFT, receiver sdk.AccAddress) error {
+	if !n.HasClass(ctx, token.ClassId) {
+		return errorsmod.Wrap(nft.ErrClassNotExists, token.ClassId)
+	}
+	if n.HasNFT(ctx, token.ClassId, token.Id) {
+		return errorsmod.Wrap(nft.ErrNFTExists, token.Id)
+	}
+	n.owners[token.ClassId+"/"+token.Id] = receiver
+	return nil
+}
+
+func (n *MockNFTKeeper) Burn(ctx context.Context, classID, nftID string) error {
+	if !n.HasNFT(ctx, classID, nftID) {
+		return errorsmod.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+	delete(n.owners, classID+"/"+nftID)
+	return nil
+}
+
+func (n *MockNFTKeeper) Transfer(ctx context.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	if !n.HasNFT(ctx, classID, nftID) {
+		return errorsmod.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+	if err := n.failures[classID+"/"+nftID]; err != nil {
+		return err
+	}
+	n.owners[classID+"/"+nftID] = receiver
+	return nil
+}
+
+func (n *MockNFTKeeper) GetOwner(_ context.Context, classID, nftID string) sdk.AccAddress {
+	return n.owners[classID+"/"+nftID]
+}
+
+func (n *MockNFTKeeper) GetNFTsOfClassByOwner(_ context.Context, classID string, owner sdk.AccAddress) []nft.NFT {
+	var nfts []nft.NFT
+	for key, holder := range n.owners {
+		id, ok := strings.CutPrefix(key, classID+"/")
+		if ok && holder.Equals(owner) {
+			nfts = append(nfts, nft.NFT{ClassId: classID, Id: id})
+		}
+	}
+	sort.Slice(nfts, func(i, j int) bool { return nfts[i].Id < nfts[j].Id })
+	return nfts
+}
+
+func (n *MockNFTKeeper) HasNFT(_ context.Context, classID, id string) bool {
+	_, ok := n.owners[classID+"/"+id]
+	return ok
+}
@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+// SetAuthorMigration stores an author migration still in progress.
+func (k Keeper) SetAuthorMigration(ctx sdk.Context, migration types.AuthorMigration) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuthorMigrationKey))
+	b := k.cdc.MustMarshal(&migration)
+	store.Set([]byte(migration.OldAuthor), b)
+}
+
+// GetAuthorMigration returns the migration in progress of an old author.
+func (k Keeper) GetAuthorMigration(ctx sdk.Context, oldAuthor string) (val types.AuthorMigration, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuthorMigrationKey))
+	b := store.Get([]byte(oldAuthor))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAuthorMigration removes the migration of an old author.
+func (k Keeper) RemoveAuthorMigration(ctx sdk.Context, oldAuthor string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuthorMigrationKey))
+	store.Delete([]byte(oldAuthor))
+}
+
+// GetAllAuthorMigration returns all the author migrations in progress.
+func (k Keeper) GetAllAuthorMigration(ctx sdk.Context) (list []types.AuthorMigration) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuthorMigrationKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuthorMigration
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// MigrateAuthorTips adds the tips received by an old author to those of the
+// new one.
+func (k Keeper) MigrateAuthorTips(ctx sdk.Context, oldAuthor, newAuthor string) {
+	tips := k.GetAuthorTips(ctx, oldAuthor)
+	if tips.IsZero() {
+		return
+	}
+	k.SetAuthorTips(ctx, types.AuthorTips{
+		Author: newAuthor,
+		Tips:   k.GetAuthorTips(ctx, newAuthor).Add(tips...),
+	})
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuthorTipsKey))
+	store.Delete([]byte(oldAuthor))
+}
+
+// MigrateAuthorPosts moves up to limit posts, along with their NFTs, from an
+// old author to a new one, then hands over the editor entries of the old
+// author within what is left of limit. It returns how many posts and editor
+// entries were moved; fewer than limit means nothing is left to move.
+func (k Keeper) MigrateAuthorPosts(ctx sdk.Context, oldAuthor, newAuthor string, limit int) (int, error) {
+	newAuthorAddr, err := sdk.AccAddressFromBech32(newAuthor)
+	if err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new author address (%s)", err)
+	}
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostCreatorKeyPrefix(oldAuthor))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var ids []uint64
+	for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		post, found := k.GetPost(ctx, id)
+		if !found {
+			k.removePostCreatorIndex(ctx, oldAuthor, id)
+			continue
+		}
+		owner := newAuthor
+		if k.HasPostNFT(ctx, id) {
+			// a post NFT sent away through x/nft stays with its holder
+			if nftOwner := k.PostOwner(ctx, post); nftOwner != oldAuthor {
+				owner = nftOwner
+			} else if err := k.nftKeeper.Transfer(ctx, types.PostNFTClassID, types.PostNFTID(id), newAuthorAddr); err != nil {
+				return 0, errorsmod.Wrapf(err, "failed to transfer nft of post %d", id)
+			}
+		}
+		post.Creator = owner
+		if post.DeletedBy == oldAuthor {
+			post.DeletedBy = newAuthor
+		}
+		post.Editors = slices.DeleteFunc(post.Editors, func(editor string) bool {
+			return editor == owner
+		})
+		k.SetPost(ctx, post)
+	}
+	if len(ids) == limit {
+		return len(ids), nil
+	}
+	return len(ids) + k.migrateAuthorEditors(ctx, oldAuthor, newAuthor, limit-len(ids)), nil
+}
+
+// migrateAuthorEditors replaces an old author with a new one in the editors
+// of up to limit posts and returns how many were updated. The new author is
+// not added to posts they own or can already edit.
+func (k Keeper) migrateAuthorEditors(ctx sdk.Context, oldAuthor, newAuthor string, limit int) int {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostEditorKeyPrefix(oldAuthor))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var ids []uint64
+	for ; iterator.Valid() && len(ids) < limit; iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	iterator.Close()
+
+	for _, id := range ids {
+		post, found := k.GetPost(ctx, id)
+		if !found {
+			store.Delete(GetPostIDBytes(id))
+			continue
+		}
+		keep := k.PostOwner(ctx, post) != newAuthor && !isPostEditor(post, newAuthor)
+		post.Editors = slices.DeleteFunc(post.Editors, func(editor string) bool {
+			return editor == oldAuthor
+		})
+		if keep {
+			post.Editors = append(post.Editors, newAuthor)
+		}
+		k.SetPost(ctx, post)
+	}
+	return len(ids)
+}
+
+// ProcessAuthorMigrations continues the author migrations in progress,
+// moving at most AuthorMigrationBatchSize posts in total. Each batch is
+// written only if it succeeds. A failed batch leaves the posts untouched and
+// drops the migration instead of retrying it every block; the old author can
+// request it again, which reports the error in their transaction.
+func (k Keeper) ProcessAuthorMigrations(ctx sdk.Context) {
+	budget := types.AuthorMigrationBatchSize
+	for _, migration := range k.GetAllAuthorMigration(ctx) {
+		if budget == 0 {
+			return
+		}
+		cacheCtx, write := ctx.CacheContext()
+		migrated, err := k.MigrateAuthorPosts(cacheCtx, migration.OldAuthor, migration.NewAuthor, budget)
+		if err != nil {
+			k.Logger().Error("failed to migrate author", "old_author", migration.OldAuthor, "error", err)
+			k.RemoveAuthorMigration(ctx, migration.OldAuthor)
+			continue
+		}
+		write()
+		if migrated < budget {
+			k.RemoveAuthorMigration(ctx, migration.OldAuthor)
+		}
+		budget -= migrated
+	}
+}
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/x/nft"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "blog/testutil/keeper"
+	"blog/testutil/sample"
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+)
+
+func TestMigrateAuthor(t *testing.T) {
+	k, _, nftKeeper, ctx := keepertest.BlogKeeperWithMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	oldAuthor, newAuthor, holder := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	countPosts := func(creator string) uint64 {
+		res, err := k.ListPostsByCreator(ctx, &types.QueryListPostsByCreatorRequest{
+			Creator:        creator,
+			IncludeDeleted: true,
+			Pagination:     &query.PageRequest{CountTotal: true},
+		})
+		require.NoError(t, err)
+		return res.Pagination.Total
+	}
+
+	total := types.AuthorMigrationBatchSize + 50
+	for i := 0; i < total; i++ {
+		_, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: oldAuthor, Title: "title", Body: "body"})
+		require.NoError(t, err)
+	}
+	// one post NFT was sold through x/nft, another post was deleted
+	require.NoError(t, nftKeeper.Transfer(ctx, types.PostNFTClassID, types.PostNFTID(0), sdk.MustAccAddressFromBech32(holder)))
+	_, err := ms.DeletePost(ctx, &types.MsgDeletePost{Creator: oldAuthor, Id: 1})
+	require.NoError(t, err)
+	k.SetAuthorTips(ctx, types.AuthorTips{Author: oldAuthor, Tips: sdk.NewCoins(sdk.NewInt64Coin("stake", 7))})
+
+	res, err := ms.MigrateAuthor(ctx, &types.MsgMigrateAuthor{OldAuthor: oldAuthor, NewAuthor: newAuthor})
+	require.NoError(t, err)
+	require.Equal(t, uint64(types.AuthorMigrationBatchSize), res.Migrated)
+	require.False(t, res.Done)
+	// the sold post went to its holder before the batch
+	require.Equal(t, uint64(49), countPosts(oldAuthor))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 7)), k.GetAuthorTips(ctx, newAuthor))
+	require.True(t, k.GetAuthorTips(ctx, oldAuthor).IsZero())
+
+	_, err = ms.MigrateAuthor(ctx, &types.MsgMigrateAuthor{OldAuthor: oldAuthor, NewAuthor: holder})
+	require.ErrorIs(t, err, types.ErrAuthorMigration)
+
+	k.ProcessAuthorMigrations(ctx)
+	_, found := k.GetAuthorMigration(ctx, oldAuthor)
+	require.False(t, found)
+	require.Zero(t, countPosts(oldAuthor))
+	require.Equal(t, uint64(total-1), countPosts(newAuthor))
+	require.Equal(t, uint64(1), countPosts(holder))
+
+	post, _ := k.GetPost(ctx, 1)
+	require.Equal(t, newAuthor, post.Creator)
+	require.Equal(t, newAuthor, post.DeletedBy)
+	require.Equal(t, oldAuthor, post.OriginalAuthor)
+	post, _ = k.GetPost(ctx, uint64(total-1))
+	require.Equal(t, newAuthor, k.PostOwner(ctx, post))
+
+	// an author without posts is migrated right away
+	res, err = ms.MigrateAuthor(ctx, &types.MsgMigrateAuthor{OldAuthor: oldAuthor, NewAuthor: newAuthor})
+	require.NoError(t, err)
+	require.Zero(t, res.Migrated)
+	require.True(t, res.Done)
+}
+
+func TestProcessAuthorMigrationsFailure(t *testing.T) {
+	k, _, nftKeeper, ctx := keepertest.BlogKeeperWithMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	oldAuthor, newAuthor := sample.AccAddress(), sample.AccAddress()
+
+	for i := 0; i < 3; i++ {
+		_, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: oldAuthor, Title: "title", Body: "body"})
+		require.NoError(t, err)
+	}
+	// the NFT of the last post can no longer be moved
+	nftKeeper.FailTransfer(types.PostNFTClassID, types.PostNFTID(2), nft.ErrNFTNotExists)
+	k.SetAuthorMigration(ctx, types.AuthorMigration{OldAuthor: oldAuthor, NewAuthor: newAuthor})
+
+	k.ProcessAuthorMigrations(ctx)
+	_, found := k.GetAuthorMigration(ctx, oldAuthor)
+	require.False(t, found)
+	for _, post := range k.GetAllPost(ctx) {
+		require.Equal(t, oldAuthor, post.Creator)
+	}
+
+	// asking again reports the error to the old author
+	_, err := ms.MigrateAuthor(ctx, &types.MsgMigrateAuthor{OldAuthor: oldAuthor, NewAuthor: newAuthor})
+	require.ErrorIs(t, err, nft.ErrNFTNotExists)
+}
+
+func TestMigrateAuthorEditors(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	oldAuthor, newAuthor, owner := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	editable := func(account string) []uint64 {
+		res, err := k.ListPostsByEditor(ctx, &types.QueryListPostsByEditorRequest{Editor: account})
+		require.NoError(t, err)
+		var ids []uint64
+		for _, post := range res.Post {
+			ids = append(ids, post.Id)
+		}
+		return ids
+	}
+	createPost := func(creator string, editors ...string) uint64 {
+		created, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: creator, Title: "title", Body: "body"})
+		require.NoError(t, err)
+		for _, editor := range editors {
+			_, err = ms.AddEditor(ctx, &types.MsgAddEditor{Creator: creator, Id: created.Id, Editor: editor})
+			require.NoError(t, err)
+		}
+		return created.Id
+	}
+
+	shared := createPost(owner, oldAuthor)
+	both := createPost(owner, oldAuthor, newAuthor)
+	ownedByNew := createPost(newAuthor, oldAuthor)
+	// the old author's own post has the new author as an editor
+	own := createPost(oldAuthor, newAuthor)
+
+	res, err := ms.MigrateAuthor(ctx, &types.MsgMigrateAuthor{OldAuthor: oldAuthor, NewAuthor: newAuthor})
+	require.NoError(t, err)
+	require.True(t, res.Done)
+	require.EqualValues(t, 4, res.Migrated)
+
+	require.Empty(t, editable(oldAuthor))
+	require.ElementsMatch(t, []uint64{shared, both}, editable(newAuthor))
+	post, _ := k.GetPost(sdkCtx, shared)
+	require.Equal(t, []string{newAuthor}, post.Editors)
+	post, _ = k.GetPost(sdkCtx, both)
+	require.Equal(t, []string{newAuthor}, post.Editors)
+	post, _ = k.GetPost(sdkCtx, ownedByNew)
+	require.Empty(t, post.Editors)
+	post, _ = k.GetPost(sdkCtx, own)
+	require.Equal(t, newAuthor, post.Creator)
+	require.Empty(t, post.Editors)
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// BlockAddress adds an address to the blocklist.
+func (k Keeper) BlockAddress(ctx context.Context, address string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BlocklistKey))
+	store.Set([]byte(address), []byte{})
+}
+
+// UnblockAddress removes an address from the blocklist.
+func (k Keeper) UnblockAddress(ctx context.Context, address string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BlocklistKey))
+	store.Delete([]byte(address))
+}
+
+// IsBlocked reports whether an address is on the blocklist.
+func (k Keeper) IsBlocked(ctx context.Context, address string) bool {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BlocklistKey))
+	return store.Has([]byte(address))
+}
+
+// GetBlocklist returns every blocked address.
+func (k Keeper) GetBlocklist(ctx context.Context) []string {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.BlocklistKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	list := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Key()))
+	}
+	return list
+}
+
+// checkNotBlocked returns ErrAddressBlocked if signer is on the blocklist.
+// The messages that publish, change, hand over or interact with posts check
+// it for every account they act for.
+func (k Keeper) checkNotBlocked(ctx context.Context, signer string) error {
+	if k.IsBlocked(ctx, signer) {
+		return errorsmod.Wrap(types.ErrAddressBlocked, signer)
+	}
+	return nil
+}
+
+// HideCreatorPosts hides every post held by a creator that is not hidden
+// yet, and returns how many were hidden.
+func (k Keeper) HideCreatorPosts(ctx sdk.Context, creator string, reason types.HideReason, hiddenBy string) uint64 {
+	k.syncCreatorPosts(ctx, creator)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostCreatorKeyPrefix(creator))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	iterator.Close()
+
+	var hidden uint64
+	for _, id := range ids {
+		post, found := k.GetPost(ctx, id)
+		if !found || post.Hidden {
+			continue
+		}
+		post.Hidden = true
+		post.HideReason = reason
+		post.HiddenBy = hiddenBy
+		k.SetPost(ctx, post)
+		hidden++
+	}
+	return hidden
+}
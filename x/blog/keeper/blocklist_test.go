@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func TestUpdateBlocklist(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	spammer, other := sample.AccAddress(), sample.AccAddress()
+	authority := k.GetAuthority()
+
+	var ids []uint64
+	for i := 0; i < 2; i++ {
+		created, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: spammer, Title: "title", Body: "body"})
+		require.NoError(t, err)
+		ids = append(ids, created.Id)
+	}
+	created, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: other, Title: "title", Body: "body"})
+	require.NoError(t, err)
+	otherID := created.Id
+
+	_, err = ms.UpdateBlocklist(ctx, &types.MsgUpdateBlocklist{Authority: other, Block: []string{spammer}})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	res, err := ms.UpdateBlocklist(ctx, &types.MsgUpdateBlocklist{Authority: authority, Block: []string{spammer}, HidePosts: true})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, res.Hidden)
+	require.True(t, k.IsBlocked(ctx, spammer))
+	for _, id := range ids {
+		post, _ := k.GetPost(sdk.UnwrapSDKContext(ctx), id)
+		require.True(t, post.Hidden)
+		require.Equal(t, types.HideReason_HIDE_REASON_BLOCKED, post.HideReason)
+		require.Equal(t, authority, post.HiddenBy)
+	}
+	post, _ := k.GetPost(sdk.UnwrapSDKContext(ctx), otherID)
+	require.False(t, post.Hidden)
+
+	// blocked addresses can neither post nor interact
+	_, err = ms.CreatePost(ctx, &types.MsgCreatePost{Creator: spammer, Title: "title", Body: "body"})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+	_, err = ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: spammer, Id: ids[0], Title: "title", Body: "body"})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+	_, err = ms.CreateComment(ctx, &types.MsgCreateComment{Creator: spammer, PostId: otherID, Body: "body"})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+	_, err = ms.ReactToPost(ctx, &types.MsgReactToPost{Creator: spammer, PostId: otherID, Kind: types.ReactionKind_REACTION_KIND_LIKE})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+	_, err = ms.ReportPost(ctx, &types.MsgReportPost{Creator: spammer, Id: otherID, Reason: types.HideReason_HIDE_REASON_SPAM})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+
+	// unblocking lets the address post again but leaves its posts hidden
+	_, err = ms.UpdateBlocklist(ctx, &types.MsgUpdateBlocklist{Authority: authority, Unblock: []string{spammer}})
+	require.NoError(t, err)
+	require.False(t, k.IsBlocked(ctx, spammer))
+	require.Empty(t, k.GetBlocklist(ctx))
+	_, err = ms.CreatePost(ctx, &types.MsgCreatePost{Creator: spammer, Title: "title", Body: "body"})
+	require.NoError(t, err)
+	post, _ = k.GetPost(sdk.UnwrapSDKContext(ctx), ids[0])
+	require.True(t, post.Hidden)
+}
+
+func TestBlockedAccounts(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(now)
+	spammer, other := sample.AccAddress(), sample.AccAddress()
+	draftStatus := types.PostStatus_POST_STATUS_DRAFT
+	launch := now.Add(time.Hour)
+
+	draft, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: spammer, Title: "draft", Status: draftStatus})
+	require.NoError(t, err)
+	scheduled, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: spammer, Title: "scheduled", Status: draftStatus, PublishAt: &launch})
+	require.NoError(t, err)
+	deleted, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: spammer, Title: "deleted", Body: "body"})
+	require.NoError(t, err)
+	_, err = ms.DeletePost(sdkCtx, &types.MsgDeletePost{Creator: spammer, Id: deleted.Id})
+	require.NoError(t, err)
+	offered, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: other, Title: "offered", Body: "body"})
+	require.NoError(t, err)
+	_, err = ms.TransferPost(sdkCtx, &types.MsgTransferPost{Creator: other, Id: offered.Id, NewOwner: spammer, Offer: true})
+	require.NoError(t, err)
+
+	_, err = ms.UpdateBlocklist(sdkCtx, &types.MsgUpdateBlocklist{Authority: k.GetAuthority(), Block: []string{spammer}})
+	require.NoError(t, err)
+
+	_, err = ms.PublishPost(sdkCtx, &types.MsgPublishPost{Creator: spammer, Id: draft.Id})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+	_, err = ms.RestorePost(sdkCtx, &types.MsgRestorePost{Creator: spammer, Id: deleted.Id})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+	_, err = ms.AddEditor(sdkCtx, &types.MsgAddEditor{Creator: spammer, Id: draft.Id, Editor: other})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+	_, err = ms.AddEditor(sdkCtx, &types.MsgAddEditor{Creator: other, Id: offered.Id, Editor: spammer})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+	_, err = ms.TransferPost(sdkCtx, &types.MsgTransferPost{Creator: spammer, Id: draft.Id, NewOwner: other})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+	_, err = ms.TransferPost(sdkCtx, &types.MsgTransferPost{Creator: other, Id: offered.Id, NewOwner: spammer})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+	_, err = ms.AcceptPostTransfer(sdkCtx, &types.MsgAcceptPostTransfer{Creator: spammer, Id: offered.Id})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+	_, err = ms.MigrateAuthor(sdkCtx, &types.MsgMigrateAuthor{OldAuthor: spammer, NewAuthor: other})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+	_, err = ms.MigrateAuthor(sdkCtx, &types.MsgMigrateAuthor{OldAuthor: other, NewAuthor: spammer})
+	require.ErrorIs(t, err, types.ErrAddressBlocked)
+
+	// a scheduled draft of a blocked owner stays a draft and leaves the queue
+	k.PublishScheduledPosts(sdkCtx.WithBlockTime(launch))
+	post, _ := k.GetPost(sdkCtx, scheduled.Id)
+	require.Equal(t, draftStatus, post.Status)
+	require.True(t, post.PublishAt.IsZero())
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// GetCommentKeyPrefix returns the store prefix holding all comments of a post.
+func GetCommentKeyPrefix(postID uint64) []byte {
+	return append(types.KeyPrefix(types.CommentKey), GetPostIDBytes(postID)...)
+}
+
+func GetCommentIDBytes(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	return bz
+}
+
+// GetCommentReplyKeyPrefix returns the store prefix indexing the direct replies of a comment.
+func GetCommentReplyKeyPrefix(postID uint64, parentID uint64) []byte {
+	key := append(types.KeyPrefix(types.CommentReplyKey), GetPostIDBytes(postID)...)
+	return append(key, GetCommentIDBytes(parentID)...)
+}
+
+func (k Keeper) AppendComment(ctx sdk.Context, comment types.Comment) uint64 {
+	count := k.GetCommentCount(ctx)
+	comment.Id = count
+	k.SetComment(ctx, comment)
+	if comment.Depth > 0 {
+		k.SetCommentReply(ctx, comment)
+	}
+	k.SetCommentCount(ctx, count+1)
+	return count
+}
+
+func (k Keeper) GetCommentCount(ctx sdk.Context) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.CommentCountKey)
+	bz := store.Get(byteKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) SetCommentCount(ctx sdk.Context, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, []byte{})
+	byteKey := types.KeyPrefix(types.CommentCountKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(byteKey, bz)
+}
+
+func (k Keeper) GetComment(ctx sdk.Context, postID uint64, id uint64) (val types.Comment, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetCommentKeyPrefix(postID))
+	b := store.Get(GetCommentIDBytes(id))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+func (k Keeper) SetComment(ctx sdk.Context, comment types.Comment) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetCommentKeyPrefix(comment.PostId))
+	b := k.cdc.MustMarshal(&comment)
+	store.Set(GetCommentIDBytes(comment.Id), b)
+}
+
+func (k Keeper) RemoveComment(ctx sdk.Context, postID uint64, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetCommentKeyPrefix(postID))
+	store.Delete(GetCommentIDBytes(id))
+}
+
+// SetCommentReply indexes a reply under its parent comment.
+func (k Keeper) SetCommentReply(ctx sdk.Context, reply types.Comment) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetCommentReplyKeyPrefix(reply.PostId, reply.ParentId))
+	store.Set(GetCommentIDBytes(reply.Id), []byte{})
+}
+
+// GetCommentReplyIDs returns the IDs of the direct replies of a comment.
+func (k Keeper) GetCommentReplyIDs(ctx sdk.Context, postID uint64, parentID uint64) (ids []uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetCommentReplyKeyPrefix(postID, parentID))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, binary.BigEndian.Uint64(iterator.Key()))
+	}
+
+	return
+}
+
+// DeleteCommentNode deletes a comment. A comment with replies is kept as a
+// tombstone with its body cleared, so that replies written by other accounts
+// survive; a tombstone is removed in turn once its last reply is gone.
+func (k Keeper) DeleteCommentNode(ctx sdk.Context, comment types.Comment) {
+	if len(k.GetCommentReplyIDs(ctx, comment.PostId, comment.Id)) > 0 {
+		comment.Body = ""
+		comment.Deleted = true
+		k.SetComment(ctx, comment)
+		return
+	}
+
+	k.RemoveComment(ctx, comment.PostId, comment.Id)
+	if comment.Depth == 0 {
+		return
+	}
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	parentStore := prefix.NewStore(storeAdapter, GetCommentReplyKeyPrefix(comment.PostId, comment.ParentId))
+	parentStore.Delete(GetCommentIDBytes(comment.Id))
+	if parent, found := k.GetComment(ctx, comment.PostId, comment.ParentId); found && parent.Deleted {
+		k.DeleteCommentNode(ctx, parent)
+	}
+}
+
+// RemovePostComments removes every comment attached to a post.
+func (k Keeper) RemovePostComments(ctx sdk.Context, postID uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	for _, keyPrefix := range [][]byte{
+		GetCommentKeyPrefix(postID),
+		append(types.KeyPrefix(types.CommentReplyKey), GetPostIDBytes(postID)...),
+	} {
+		store := prefix.NewStore(storeAdapter, keyPrefix)
+		iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+}
+
+func (k Keeper) GetAllComment(ctx sdk.Context) (list []types.Comment) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.CommentKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Comment
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func TestCommentMsgServer(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	author := sample.AccAddress()
+	commenter := sample.AccAddress()
+
+	_, err := ms.CreateComment(sdkCtx, &types.MsgCreateComment{Creator: commenter, PostId: 0, Body: "first"})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	postRes, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: author, Title: "title", Body: "body"})
+	require.NoError(t, err)
+
+	var ids []uint64
+	for _, body := range []string{"first", "second", "third"} {
+		res, err := ms.CreateComment(sdkCtx, &types.MsgCreateComment{Creator: commenter, PostId: postRes.Id, Body: body})
+		require.NoError(t, err)
+		ids = append(ids, res.Id)
+	}
+	require.Equal(t, []uint64{0, 1, 2}, ids)
+
+	_, err = ms.UpdateComment(sdkCtx, &types.MsgUpdateComment{Creator: author, PostId: postRes.Id, Id: ids[0], Body: "edited"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.UpdateComment(sdkCtx, &types.MsgUpdateComment{Creator: commenter, PostId: postRes.Id, Id: ids[0], Body: "edited"})
+	require.NoError(t, err)
+
+	_, err = ms.DeleteComment(sdkCtx, &types.MsgDeleteComment{Creator: author, PostId: postRes.Id, Id: ids[1]})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.DeleteComment(sdkCtx, &types.MsgDeleteComment{Creator: commenter, PostId: postRes.Id, Id: ids[1]})
+	require.NoError(t, err)
+
+	listRes, err := k.ListCommentsByPost(sdkCtx, &types.QueryListCommentsByPostRequest{
+		PostId:     postRes.Id,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, listRes.Comments, 1)
+	require.Equal(t, "edited", listRes.Comments[0].Body)
+	require.Equal(t, uint64(2), listRes.Pagination.Total)
+}
+
+func TestPurgedPostRemovesComments(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	author := sample.AccAddress()
+
+	postRes, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: author, Title: "title", Body: "body"})
+	require.NoError(t, err)
+	_, err = ms.CreateComment(sdkCtx, &types.MsgCreateComment{Creator: sample.AccAddress(), PostId: postRes.Id, Body: "reply"})
+	require.NoError(t, err)
+
+	_, err = ms.DeletePost(sdkCtx, &types.MsgDeletePost{Creator: author, Id: postRes.Id})
+	require.NoError(t, err)
+	_, err = ms.CreateComment(sdkCtx, &types.MsgCreateComment{Creator: sample.AccAddress(), PostId: postRes.Id, Body: "late"})
+	require.ErrorIs(t, err, types.ErrPostDeleted)
+	require.Len(t, k.GetAllComment(sdkCtx), 1)
+
+	k.PurgeExpiredPosts(sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(types.DefaultDeleteGracePeriod)))
+	require.Empty(t, k.GetAllComment(sdkCtx))
+}
@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func TestCommentThread(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	creator := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.MaxCommentDepth = 2
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	postRes, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "title", Body: "body"})
+	require.NoError(t, err)
+	rootRes, err := ms.CreateComment(sdkCtx, &types.MsgCreateComment{Creator: creator, PostId: postRes.Id, Body: "root"})
+	require.NoError(t, err)
+
+	reply := func(parentID uint64, body string) (*types.MsgReplyCommentResponse, error) {
+		return ms.ReplyComment(sdkCtx, &types.MsgReplyComment{Creator: creator, PostId: postRes.Id, ParentId: parentID, Body: body})
+	}
+	first, err := reply(rootRes.Id, "first")
+	require.NoError(t, err)
+	_, err = reply(rootRes.Id, "second")
+	require.NoError(t, err)
+	nested, err := reply(first.Id, "nested")
+	require.NoError(t, err)
+
+	_, err = reply(nested.Id, "too deep")
+	require.ErrorIs(t, err, types.ErrMaxCommentDepth)
+
+	res, err := k.CommentThread(sdkCtx, &types.QueryCommentThreadRequest{
+		PostId:     postRes.Id,
+		CommentId:  rootRes.Id,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "root", res.Thread.Comment.Body)
+	require.Len(t, res.Thread.Replies, 1)
+	require.Equal(t, "first", res.Thread.Replies[0].Comment.Body)
+	require.Len(t, res.Thread.Replies[0].Replies, 1)
+	require.Equal(t, "nested", res.Thread.Replies[0].Replies[0].Comment.Body)
+	require.NotEmpty(t, res.Pagination.NextKey)
+
+	res, err = k.CommentThread(sdkCtx, &types.QueryCommentThreadRequest{
+		PostId:     postRes.Id,
+		CommentId:  rootRes.Id,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Thread.Replies, 1)
+	require.Equal(t, "second", res.Thread.Replies[0].Comment.Body)
+	require.Empty(t, res.Pagination.NextKey)
+
+	// a depth-limited thread points at the replies it left out
+	res, err = k.CommentThread(sdkCtx, &types.QueryCommentThreadRequest{
+		PostId:    postRes.Id,
+		CommentId: rootRes.Id,
+		MaxDepth:  1,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Thread.Replies, 2)
+	require.Empty(t, res.Thread.Replies[0].Replies)
+	require.NotEmpty(t, res.Thread.Replies[0].RepliesNextKey)
+	require.Empty(t, res.Thread.Replies[1].RepliesNextKey)
+
+	listRes, err := k.ListCommentsByPost(sdkCtx, &types.QueryListCommentsByPostRequest{PostId: postRes.Id, TopLevelOnly: true})
+	require.NoError(t, err)
+	require.Len(t, listRes.Comments, 1)
+
+	// deleting a comment with replies leaves a tombstone so the replies of
+	// other accounts survive
+	other := sample.AccAddress()
+	otherReply, err := ms.ReplyComment(sdkCtx, &types.MsgReplyComment{Creator: other, PostId: postRes.Id, ParentId: first.Id, Body: "other"})
+	require.NoError(t, err)
+	_, err = ms.DeleteComment(sdkCtx, &types.MsgDeleteComment{Creator: creator, PostId: postRes.Id, Id: first.Id})
+	require.NoError(t, err)
+	tombstone, found := k.GetComment(sdkCtx, postRes.Id, first.Id)
+	require.True(t, found)
+	require.True(t, tombstone.Deleted)
+	require.Empty(t, tombstone.Body)
+	kept, found := k.GetComment(sdkCtx, postRes.Id, otherReply.Id)
+	require.True(t, found)
+	require.Equal(t, "other", kept.Body)
+	require.Len(t, k.GetCommentReplyIDs(sdkCtx, postRes.Id, first.Id), 2)
+
+	_, err = ms.DeleteComment(sdkCtx, &types.MsgDeleteComment{Creator: creator, PostId: postRes.Id, Id: first.Id})
+	require.ErrorIs(t, err, types.ErrCommentDeleted)
+	_, err = ms.UpdateComment(sdkCtx, &types.MsgUpdateComment{Creator: creator, PostId: postRes.Id, Id: first.Id, Body: "back"})
+	require.ErrorIs(t, err, types.ErrCommentDeleted)
+	_, err = reply(first.Id, "late")
+	require.ErrorIs(t, err, types.ErrCommentDeleted)
+
+	// the tombstone goes away with its last reply
+	_, err = ms.DeleteComment(sdkCtx, &types.MsgDeleteComment{Creator: creator, PostId: postRes.Id, Id: nested.Id})
+	require.NoError(t, err)
+	_, found = k.GetComment(sdkCtx, postRes.Id, first.Id)
+	require.True(t, found)
+	_, err = ms.DeleteComment(sdkCtx, &types.MsgDeleteComment{Creator: other, PostId: postRes.Id, Id: otherReply.Id})
+	require.NoError(t, err)
+	_, found = k.GetComment(sdkCtx, postRes.Id, first.Id)
+	require.False(t, found)
+	require.Len(t, k.GetCommentReplyIDs(sdkCtx, postRes.Id, rootRes.Id), 1)
+}
+
+func TestCommentThreadLimits(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	creator := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.MaxCommentDepth = 2
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	postRes, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "title", Body: "body"})
+	require.NoError(t, err)
+	rootRes, err := ms.CreateComment(sdkCtx, &types.MsgCreateComment{Creator: creator, PostId: postRes.Id, Body: "root"})
+	require.NoError(t, err)
+	reply := func(parentID uint64) uint64 {
+		res, err := ms.ReplyComment(sdkCtx, &types.MsgReplyComment{Creator: creator, PostId: postRes.Id, ParentId: parentID, Body: "reply"})
+		require.NoError(t, err)
+		return res.Id
+	}
+	// 60 replies with 5 replies each
+	for i := 0; i < 60; i++ {
+		id := reply(rootRes.Id)
+		for j := 0; j < 5; j++ {
+			reply(id)
+		}
+	}
+
+	res, err := k.CommentThread(sdkCtx, &types.QueryCommentThreadRequest{
+		PostId:     postRes.Id,
+		CommentId:  rootRes.Id,
+		Pagination: &query.PageRequest{Limit: 1000},
+	})
+	require.NoError(t, err)
+	// the page is clamped to 50 replies per level
+	require.Len(t, res.Thread.Replies, 50)
+	require.NotEmpty(t, res.Pagination.NextKey)
+
+	// and the whole thread to 250 comments; the replies left out are
+	// pointed at
+	nodes := 1
+	for _, node := range res.Thread.Replies {
+		nodes += 1 + len(node.Replies)
+	}
+	require.Equal(t, 250, nodes)
+	require.Len(t, res.Thread.Replies[38].Replies, 5)
+	require.Empty(t, res.Thread.Replies[38].RepliesNextKey)
+	require.Len(t, res.Thread.Replies[39].Replies, 4)
+	require.NotEmpty(t, res.Thread.Replies[39].RepliesNextKey)
+	require.Empty(t, res.Thread.Replies[49].Replies)
+	require.NotEmpty(t, res.Thread.Replies[49].RepliesNextKey)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// GetFeaturedPostPositionBytes returns the store key of the featured post at
+// a display position.
+func GetFeaturedPostPositionBytes(position uint64) []byte {
+	return sdk.Uint64ToBigEndian(position)
+}
+
+// GetFeaturedPostExpiryQueueKey returns the expiry queue key of a featured
+// post, ordered by expiry time.
+func GetFeaturedPostExpiryQueueKey(expiresAt time.Time, position uint64) []byte {
+	return append(sdk.FormatTimeBytes(expiresAt), GetFeaturedPostPositionBytes(position)...)
+}
+
+// ReplaceFeaturedPosts replaces the featured posts with the given list, kept in
+// order, and queues the entries that expire.
+func (k Keeper) ReplaceFeaturedPosts(ctx sdk.Context, featured []types.FeaturedPost) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeaturedPostKey))
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeaturedPostExpiryQueueKey))
+
+	for _, s := range []prefix.Store{store, queue} {
+		iterator := storetypes.KVStorePrefixIterator(s, []byte{})
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keys {
+			s.Delete(key)
+		}
+	}
+
+	for i, elem := range featured {
+		position := uint64(i)
+		store.Set(GetFeaturedPostPositionBytes(position), k.cdc.MustMarshal(&elem))
+		if elem.ExpiresAt != nil {
+			queue.Set(GetFeaturedPostExpiryQueueKey(*elem.ExpiresAt, position), []byte{})
+		}
+	}
+}
+
+// GetAllFeaturedPost returns the featured posts in display order.
+func (k Keeper) GetAllFeaturedPost(ctx sdk.Context) (list []types.FeaturedPost) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeaturedPostKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FeaturedPost
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveExpiredFeaturedPosts removes every featured post whose expiry time is
+// at or before the current block time. The remaining entries keep their
+// order.
+func (k Keeper) RemoveExpiredFeaturedPosts(ctx sdk.Context) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeaturedPostKey))
+	queue := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeaturedPostExpiryQueueKey))
+	iterator := queue.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key[len(key)-8:])
+		queue.Delete(key)
+	}
+}
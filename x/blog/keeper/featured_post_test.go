@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func TestSetFeaturedPosts(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	now := time.Unix(1_000_000, 0).UTC()
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(now)
+	authority := k.GetAuthority()
+	featured := func(ctx sdk.Context) []uint64 {
+		res, err := k.ListFeaturedPosts(ctx, &types.QueryListFeaturedPostsRequest{})
+		require.NoError(t, err)
+		require.Len(t, res.FeaturedPosts, len(res.Post))
+		var ids []uint64
+		for _, post := range res.Post {
+			ids = append(ids, post.Id)
+		}
+		return ids
+	}
+
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		created, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: sample.AccAddress(), Title: "title", Body: "body"})
+		require.NoError(t, err)
+		ids = append(ids, created.Id)
+	}
+	expiresAt := now.Add(time.Hour)
+
+	_, err := ms.SetFeaturedPosts(sdkCtx, &types.MsgSetFeaturedPosts{Authority: sample.AccAddress(), FeaturedPosts: []types.FeaturedPost{{PostId: ids[0]}}})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.SetFeaturedPosts(sdkCtx, &types.MsgSetFeaturedPosts{Authority: authority, FeaturedPosts: []types.FeaturedPost{{PostId: 99}}})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	past := now
+	_, err = ms.SetFeaturedPosts(sdkCtx, &types.MsgSetFeaturedPosts{Authority: authority, FeaturedPosts: []types.FeaturedPost{{PostId: ids[0], ExpiresAt: &past}}})
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+
+	// the list keeps the given order
+	_, err = ms.SetFeaturedPosts(sdkCtx, &types.MsgSetFeaturedPosts{Authority: authority, FeaturedPosts: []types.FeaturedPost{
+		{PostId: ids[2]},
+		{PostId: ids[0], ExpiresAt: &expiresAt},
+		{PostId: ids[1]},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{ids[2], ids[0], ids[1]}, featured(sdkCtx))
+
+	// expired entries are left out, then removed at the end of the block
+	expiredCtx := sdkCtx.WithBlockTime(expiresAt)
+	require.Equal(t, []uint64{ids[2], ids[1]}, featured(expiredCtx))
+	k.RemoveExpiredFeaturedPosts(expiredCtx)
+	require.Len(t, k.GetAllFeaturedPost(expiredCtx), 2)
+	require.Equal(t, []uint64{ids[2], ids[1]}, featured(expiredCtx))
+
+	// replacing the list drops the previous entries and their expiries
+	_, err = ms.SetFeaturedPosts(sdkCtx, &types.MsgSetFeaturedPosts{Authority: authority, FeaturedPosts: []types.FeaturedPost{
+		{PostId: ids[1], ExpiresAt: &expiresAt},
+	}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{ids[1]}, featured(sdkCtx))
+	_, err = ms.SetFeaturedPosts(sdkCtx, &types.MsgSetFeaturedPosts{Authority: authority})
+	require.NoError(t, err)
+	require.Empty(t, featured(sdkCtx))
+	k.RemoveExpiredFeaturedPosts(expiredCtx)
+	require.Empty(t, k.GetAllFeaturedPost(sdkCtx))
+
+	// only published posts that are visible can be featured
+	for _, status := range []types.PostStatus{types.PostStatus_POST_STATUS_DRAFT, types.PostStatus_POST_STATUS_UNLISTED} {
+		created, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: sample.AccAddress(), Title: "title", Body: "body", Status: status})
+		require.NoError(t, err)
+		_, err = ms.SetFeaturedPosts(sdkCtx, &types.MsgSetFeaturedPosts{Authority: authority, FeaturedPosts: []types.FeaturedPost{{PostId: created.Id}}})
+		require.ErrorIs(t, err, types.ErrInvalidPostStatus)
+	}
+	scheduled, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: sample.AccAddress(), Title: "title", Body: "body", Status: types.PostStatus_POST_STATUS_DRAFT, PublishAt: &expiresAt})
+	require.NoError(t, err)
+	_, err = ms.SetFeaturedPosts(sdkCtx, &types.MsgSetFeaturedPosts{Authority: authority, FeaturedPosts: []types.FeaturedPost{{PostId: scheduled.Id}}})
+	require.ErrorIs(t, err, types.ErrInvalidPostStatus)
+
+	// a featured post that gets hidden drops out of the list
+	moderator := sample.AccAddress()
+	params := k.GetParams(sdkCtx)
+	params.Moderators = []string{moderator}
+	require.NoError(t, k.SetParams(sdkCtx, params))
+	_, err = ms.SetFeaturedPosts(sdkCtx, &types.MsgSetFeaturedPosts{Authority: authority, FeaturedPosts: []types.FeaturedPost{{PostId: ids[0]}, {PostId: ids[1]}}})
+	require.NoError(t, err)
+	_, err = ms.HidePost(sdkCtx, &types.MsgHidePost{Creator: moderator, Id: ids[0], Reason: types.HideReason_HIDE_REASON_SPAM})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{ids[1]}, featured(sdkCtx))
+	_, err = ms.SetFeaturedPosts(sdkCtx, &types.MsgSetFeaturedPosts{Authority: authority, FeaturedPosts: []types.FeaturedPost{{PostId: ids[0]}}})
+	require.ErrorIs(t, err, types.ErrPostHidden)
+}
@@ -20,6 +20,9 @@ type (
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority string
+
+		bankKeeper types.BankKeeper
+		nftKeeper  types.NFTKeeper
 	}
 )
 
@@ -29,6 +32,8 @@ func NewKeeper(
 	logger log.Logger,
 	authority string,
 
+	bankKeeper types.BankKeeper,
+	nftKeeper types.NFTKeeper,
 ) Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -39,6 +44,9 @@ func NewKeeper(
 		storeService: storeService,
 		authority:    authority,
 		logger:       logger,
+
+		bankKeeper: bankKeeper,
+		nftKeeper:  nftKeeper,
 	}
 }
 
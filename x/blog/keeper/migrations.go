@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v10 "blog/x/blog/migrations/v10"
+	v11 "blog/x/blog/migrations/v11"
+	v12 "blog/x/blog/migrations/v12"
+	v13 "blog/x/blog/migrations/v13"
+	v2 "blog/x/blog/migrations/v2"
+	v3 "blog/x/blog/migrations/v3"
+	v4 "blog/x/blog/migrations/v4"
+	v5 "blog/x/blog/migrations/v5"
+	v6 "blog/x/blog/migrations/v6"
+	v7 "blog/x/blog/migrations/v7"
+	v8 "blog/x/blog/migrations/v8"
+	v9 "blog/x/blog/migrations/v9"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates from version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate5to6 migrates from version 5 to 6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate6to7 migrates from version 6 to 7.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate7to8 migrates from version 7 to 8.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate8to9 migrates from version 8 to 9.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate9to10 migrates from version 9 to 10.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	return v10.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate10to11 migrates from version 10 to 11.
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	return v11.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc, m.keeper.nftKeeper)
+}
+
+// Migrate11to12 migrates from version 11 to 12.
+func (m Migrator) Migrate11to12(ctx sdk.Context) error {
+	return v12.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate12to13 migrates from version 12 to 13.
+func (m Migrator) Migrate12to13(ctx sdk.Context) error {
+	return v13.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) CreateAnnouncement(goCtx context.Context, req *types.MsgCreateAnnouncement) (*types.MsgCreateAnnouncementResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	tags, err := k.checkPostContent(ctx, req.Title, req.Body, req.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	// announcements are exempt from the post fee and the storage deposit
+	var post = types.Post{
+		Creator:        req.Authority,
+		OriginalAuthor: req.Authority,
+		Title:          req.Title,
+		Body:           req.Body,
+		Tags:           tags,
+		Status:         types.PostStatus_POST_STATUS_PUBLISHED,
+		PublishAt:      ctx.BlockTime(),
+		Deposit:        NoPostDeposit(k.PostDeposit(ctx, req.Title, req.Body)),
+		Official:       true,
+		CreatedAt:      ctx.BlockTime(),
+		CreatedHeight:  ctx.BlockHeight(),
+		UpdatedAt:      ctx.BlockTime(),
+		UpdatedHeight:  ctx.BlockHeight(),
+	}
+	id := k.AppendPost(ctx, post)
+	if err := k.MintPostNFT(ctx, id, req.Authority); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateAnnouncementResponse{Id: id}, nil
+}
@@ -1,6 +1,11 @@
 package keeper
 
 import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
 	"blog/x/blog/types"
 )
 
@@ -15,3 +20,21 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 }
 
 var _ types.MsgServer = msgServer{}
+
+// checkOwner returns ErrUnauthorized unless signer is the owner of the
+// post or comment being modified.
+func checkOwner(signer string, owner string) error {
+	if signer != owner {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	}
+	return nil
+}
+
+// checkModerator returns ErrUnauthorized unless signer is in the moderator
+// set held in params.
+func (k Keeper) checkModerator(ctx context.Context, signer string) error {
+	if !k.GetParams(ctx).IsModerator(signer) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not a moderator")
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) AcceptPostTransfer(goCtx context.Context, msg *types.MsgAcceptPostTransfer) (*types.MsgAcceptPostTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotBlocked(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	val, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+	if val.Deleted {
+		return nil, errorsmod.Wrapf(types.ErrPostDeleted, "post %d", msg.Id)
+	}
+	if val.PendingOwner != msg.Creator {
+		return nil, errorsmod.Wrapf(types.ErrNoTransferOffer, "post %d was not offered to %s", msg.Id, msg.Creator)
+	}
+	// the post NFT may have changed hands since the offer was made
+	if k.PostOwner(ctx, val) != val.Creator {
+		return nil, errorsmod.Wrapf(types.ErrNoTransferOffer, "offer of post %d was made by a previous owner", msg.Id)
+	}
+	if err := k.TransferPostOwnership(ctx, val, msg.Creator); err != nil {
+		return nil, err
+	}
+	return &types.MsgAcceptPostTransferResponse{}, nil
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) AddEditor(goCtx context.Context, msg *types.MsgAddEditor) (*types.MsgAddEditorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotBlocked(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.checkNotBlocked(ctx, msg.Editor); err != nil {
+		return nil, err
+	}
+	val, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+	if err := checkOwner(msg.Creator, k.PostOwner(ctx, val)); err != nil {
+		return nil, err
+	}
+	if val.Deleted {
+		return nil, errorsmod.Wrapf(types.ErrPostDeleted, "post %d", msg.Id)
+	}
+	// announcements are written by governance alone
+	if val.Official {
+		return nil, errorsmod.Wrapf(types.ErrOfficialPost, "post %d cannot have editors", msg.Id)
+	}
+	if msg.Editor == msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the owner cannot be an editor")
+	}
+	if isPostEditor(val, msg.Editor) {
+		return nil, errorsmod.Wrapf(types.ErrEditorExists, "%s on post %d", msg.Editor, msg.Id)
+	}
+
+	val.Editors = append(val.Editors, msg.Editor)
+	k.SetPost(ctx, val)
+	return &types.MsgAddEditorResponse{}, nil
+}
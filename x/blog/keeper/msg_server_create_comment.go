@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) CreateComment(goCtx context.Context, msg *types.MsgCreateComment) (*types.MsgCreateCommentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotBlocked(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.checkCommentablePost(ctx, msg.PostId); err != nil {
+		return nil, err
+	}
+	var comment = types.Comment{
+		PostId:        msg.PostId,
+		Creator:       msg.Creator,
+		Body:          msg.Body,
+		CreatedAt:     ctx.BlockTime(),
+		CreatedHeight: ctx.BlockHeight(),
+		UpdatedAt:     ctx.BlockTime(),
+		UpdatedHeight: ctx.BlockHeight(),
+	}
+	id := k.AppendComment(
+		ctx,
+		comment,
+	)
+	return &types.MsgCreateCommentResponse{
+		Id: id,
+	}, nil
+}
+
+// checkCommentablePost returns an error unless the post exists and has not
+// been deleted.
+func (k msgServer) checkCommentablePost(ctx sdk.Context, postID uint64) error {
+	post, found := k.GetPost(ctx, postID)
+	if !found {
+		return errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("post %d doesn't exist", postID))
+	}
+	if post.Deleted {
+		return errorsmod.Wrapf(types.ErrPostDeleted, "post %d", postID)
+	}
+	return nil
+}
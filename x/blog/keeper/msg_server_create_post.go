@@ -2,24 +2,103 @@ package keeper
 
 import (
 	"context"
+	"time"
 
-	"blog/x/blog/types"
-
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
 )
 
 func (k msgServer) CreatePost(goCtx context.Context, msg *types.MsgCreatePost) (*types.MsgCreatePostResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotBlocked(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	tags, err := k.checkPostContent(ctx, msg.Title, msg.Body, msg.Tags)
+	if err != nil {
+		return nil, err
+	}
+	status := msg.Status
+	if status == types.PostStatus_POST_STATUS_UNSPECIFIED {
+		status = types.PostStatus_POST_STATUS_PUBLISHED
+	}
+	publishAt := ctx.BlockTime()
+	if status == types.PostStatus_POST_STATUS_DRAFT {
+		publishAt = time.Time{}
+	}
+	if msg.PublishAt != nil {
+		if !msg.PublishAt.After(ctx.BlockTime()) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPublishTime, "publish time %s is not in the future", msg.PublishAt)
+		}
+		publishAt = *msg.PublishAt
+	}
+	if err := k.chargePostFee(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	deposit := k.PostDeposit(ctx, msg.Title, msg.Body)
+	if err := k.AdjustPostDeposit(ctx, msg.Creator, NoPostDeposit(deposit), deposit); err != nil {
+		return nil, err
+	}
 	var post = types.Post{
-		Creator: msg.Creator,
-		Title:   msg.Title,
-		Body:    msg.Body,
+		Creator:        msg.Creator,
+		OriginalAuthor: msg.Creator,
+		Title:          msg.Title,
+		Body:           msg.Body,
+		Tags:           tags,
+		Status:         status,
+		PublishAt:      publishAt,
+		Deposit:        deposit,
+		CreatedAt:      ctx.BlockTime(),
+		CreatedHeight:  ctx.BlockHeight(),
+		UpdatedAt:      ctx.BlockTime(),
+		UpdatedHeight:  ctx.BlockHeight(),
 	}
 	id := k.AppendPost(
 		ctx,
 		post,
 	)
+	if err := k.MintPostNFT(ctx, id, msg.Creator); err != nil {
+		return nil, err
+	}
+	if msg.PublishAt != nil {
+		k.InsertPostPublishQueue(ctx, publishAt, id)
+	}
 	return &types.MsgCreatePostResponse{
 		Id: id,
 	}, nil
 }
+
+// checkPostContent checks the title and body of a post against the content
+// limits in params and returns its normalized tags.
+func (k msgServer) checkPostContent(ctx sdk.Context, title, body string, tags []string) ([]string, error) {
+	params := k.GetParams(ctx)
+	if err := params.ValidatePostContent(title, body); err != nil {
+		return nil, err
+	}
+	normalized, err := types.NormalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	if err := params.ValidateTags(normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
+// chargePostFee sends the post fee from the creator to the module account.
+func (k msgServer) chargePostFee(ctx sdk.Context, creator string) error {
+	fee := k.GetParams(ctx).PostFee
+	if !fee.IsPositive() {
+		return nil
+	}
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creatorAddr, types.ModuleName, sdk.NewCoins(fee)); err != nil {
+		return errorsmod.Wrap(err, "failed to pay post fee")
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) DeleteComment(goCtx context.Context, msg *types.MsgDeleteComment) (*types.MsgDeleteCommentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	val, found := k.GetComment(ctx, msg.PostId, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("comment %d on post %d doesn't exist", msg.Id, msg.PostId))
+	}
+	if err := checkOwner(msg.Creator, val.Creator); err != nil {
+		return nil, err
+	}
+	if val.Deleted {
+		return nil, errorsmod.Wrapf(types.ErrCommentDeleted, "comment %d on post %d", msg.Id, msg.PostId)
+	}
+	k.DeleteCommentNode(ctx, val)
+	return &types.MsgDeleteCommentResponse{}, nil
+}
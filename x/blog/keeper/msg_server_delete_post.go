@@ -17,9 +17,21 @@ func (k msgServer) DeletePost(goCtx context.Context, msg *types.MsgDeletePost) (
 	if !found {
 		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
 	}
-	if msg.Creator != val.Creator {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	if err := checkOwner(msg.Creator, k.PostOwner(ctx, val)); err != nil {
+		return nil, err
 	}
-	k.RemovePost(ctx, msg.Id)
+	if val.Deleted {
+		return nil, errorsmod.Wrapf(types.ErrPostDeleted, "post %d", msg.Id)
+	}
+	if err := k.AdjustPostDeposit(ctx, msg.Creator, val.Deposit, NoPostDeposit(val.Deposit)); err != nil {
+		return nil, err
+	}
+	if err := k.BurnPostNFT(ctx, val.Id); err != nil {
+		return nil, err
+	}
+	val.Deposit = NoPostDeposit(val.Deposit)
+	val.DeletedBy = msg.Creator
+	val.PendingOwner = ""
+	k.TombstonePost(ctx, val)
 	return &types.MsgDeletePostResponse{}, nil
 }
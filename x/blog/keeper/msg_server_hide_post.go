@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) HidePost(goCtx context.Context, msg *types.MsgHidePost) (*types.MsgHidePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkModerator(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	val, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+	// announcements are moderated by governance alone
+	if val.Official {
+		return nil, errorsmod.Wrapf(types.ErrOfficialPost, "post %d cannot be hidden", msg.Id)
+	}
+	if val.Hidden {
+		return nil, errorsmod.Wrapf(types.ErrPostHidden, "post %d", msg.Id)
+	}
+
+	val.Hidden = true
+	val.HideReason = msg.Reason
+	val.HiddenBy = msg.Creator
+	k.SetPost(ctx, val)
+	return &types.MsgHidePostResponse{}, nil
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) MigrateAuthor(goCtx context.Context, msg *types.MsgMigrateAuthor) (*types.MsgMigrateAuthorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// a blocked account can neither move its posts away nor receive others
+	for _, author := range []string{msg.OldAuthor, msg.NewAuthor} {
+		if err := k.checkNotBlocked(ctx, author); err != nil {
+			return nil, err
+		}
+	}
+	if _, found := k.GetAuthorMigration(ctx, msg.OldAuthor); found {
+		return nil, errorsmod.Wrapf(types.ErrAuthorMigration, "posts of %s are already being migrated", msg.OldAuthor)
+	}
+
+	k.MigrateAuthorTips(ctx, msg.OldAuthor, msg.NewAuthor)
+	// posts follow their NFTs, which may have changed hands through x/nft
+	k.syncCreatorPosts(ctx, msg.OldAuthor)
+	migrated, err := k.MigrateAuthorPosts(ctx, msg.OldAuthor, msg.NewAuthor, types.AuthorMigrationBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	// the remaining posts are moved at the end of the following blocks
+	done := migrated < types.AuthorMigrationBatchSize
+	if !done {
+		k.SetAuthorMigration(ctx, types.AuthorMigration{
+			OldAuthor: msg.OldAuthor,
+			NewAuthor: msg.NewAuthor,
+		})
+	}
+	return &types.MsgMigrateAuthorResponse{
+		Migrated: uint64(migrated),
+		Done:     done,
+	}, nil
+}
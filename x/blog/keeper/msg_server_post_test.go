@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "blog/testutil/keeper"
+	"blog/testutil/sample"
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+)
+
+func TestMsgServerPostTimestamps(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	creator := sample.AccAddress()
+
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(createdAt).WithBlockHeight(10)
+	res, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "title", Body: "body"})
+	require.NoError(t, err)
+
+	post, found := k.GetPost(sdkCtx, res.Id)
+	require.True(t, found)
+	require.Equal(t, createdAt, post.CreatedAt)
+	require.Equal(t, int64(10), post.CreatedHeight)
+	require.Equal(t, createdAt, post.UpdatedAt)
+	require.Equal(t, int64(10), post.UpdatedHeight)
+
+	updatedAt := createdAt.Add(time.Hour)
+	sdkCtx = sdkCtx.WithBlockTime(updatedAt).WithBlockHeight(20)
+	_, err = ms.UpdatePost(sdkCtx, &types.MsgUpdatePost{Creator: creator, Id: res.Id, Title: "new title", Body: "new body"})
+	require.NoError(t, err)
+
+	post, found = k.GetPost(sdkCtx, res.Id)
+	require.True(t, found)
+	require.Equal(t, "new title", post.Title)
+	require.Equal(t, createdAt, post.CreatedAt)
+	require.Equal(t, int64(10), post.CreatedHeight)
+	require.Equal(t, updatedAt, post.UpdatedAt)
+	require.Equal(t, int64(20), post.UpdatedHeight)
+}
+
+func TestPostContentLimits(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	creator := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.MaxTitleBytes = 5
+	params.MaxBodyBytes = 10
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	_, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "too long", Body: "body"})
+	require.ErrorIs(t, err, types.ErrTitleTooLong)
+	_, err = ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "title", Body: strings.Repeat("b", 11)})
+	require.ErrorIs(t, err, types.ErrBodyTooLong)
+	res, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "title", Body: strings.Repeat("b", 10)})
+	require.NoError(t, err)
+
+	_, err = ms.UpdatePost(sdkCtx, &types.MsgUpdatePost{Creator: creator, Id: res.Id, Title: "too long"})
+	require.ErrorIs(t, err, types.ErrTitleTooLong)
+	_, err = ms.UpdatePost(sdkCtx, &types.MsgUpdatePost{Creator: creator, Id: res.Id, Title: "title", Body: strings.Repeat("b", 11)})
+	require.ErrorIs(t, err, types.ErrBodyTooLong)
+}
+
+func TestPostFee(t *testing.T) {
+	k, bank, ctx := keepertest.BlogKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	creator := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.PostFee = sdk.NewInt64Coin("stake", 10)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: creator, Title: "title"})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	require.Zero(t, k.GetPostCount(ctx))
+
+	bank.Fund(sdk.MustAccAddressFromBech32(creator), sdk.NewCoins(sdk.NewInt64Coin("stake", 15)))
+	_, err = ms.CreatePost(ctx, &types.MsgCreatePost{Creator: creator, Title: "title"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), bank.ModuleBalance(types.ModuleName))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(creator)))
+}
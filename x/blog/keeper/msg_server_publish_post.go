@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) PublishPost(goCtx context.Context, msg *types.MsgPublishPost) (*types.MsgPublishPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotBlocked(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	val, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+	if err := checkOwner(msg.Creator, k.PostOwner(ctx, val)); err != nil {
+		return nil, err
+	}
+	if val.Deleted {
+		return nil, errorsmod.Wrapf(types.ErrPostDeleted, "post %d", msg.Id)
+	}
+	if val.Status == types.PostStatus_POST_STATUS_PUBLISHED {
+		return nil, errorsmod.Wrapf(types.ErrPostPublished, "post %d", msg.Id)
+	}
+
+	if msg.PublishAt == nil {
+		k.SetPostPublished(ctx, val, ctx.BlockTime())
+		return &types.MsgPublishPostResponse{}, nil
+	}
+	if val.Status != types.PostStatus_POST_STATUS_DRAFT {
+		return nil, errorsmod.Wrap(types.ErrInvalidPublishTime, "only drafts can be scheduled")
+	}
+	if !msg.PublishAt.After(ctx.BlockTime()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidPublishTime, "publish time %s is not in the future", msg.PublishAt)
+	}
+	k.SchedulePost(ctx, val, *msg.PublishAt)
+	return &types.MsgPublishPostResponse{}, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) ReactToPost(goCtx context.Context, msg *types.MsgReactToPost) (*types.MsgReactToPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotBlocked(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.checkCommentablePost(ctx, msg.PostId); err != nil {
+		return nil, err
+	}
+	if _, found := k.GetReaction(ctx, msg.PostId, msg.Creator, msg.Kind); found {
+		return nil, errorsmod.Wrapf(types.ErrAlreadyReacted, "%s on post %d", msg.Kind, msg.PostId)
+	}
+	k.SetReaction(ctx, types.Reaction{
+		PostId:        msg.PostId,
+		Creator:       msg.Creator,
+		Kind:          msg.Kind,
+		CreatedAt:     ctx.BlockTime(),
+		CreatedHeight: ctx.BlockHeight(),
+	})
+	return &types.MsgReactToPostResponse{}, nil
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) RemoveEditor(goCtx context.Context, msg *types.MsgRemoveEditor) (*types.MsgRemoveEditorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	val, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+	if err := checkOwner(msg.Creator, k.PostOwner(ctx, val)); err != nil {
+		return nil, err
+	}
+	if !isPostEditor(val, msg.Editor) {
+		return nil, errorsmod.Wrapf(types.ErrEditorNotFound, "%s on post %d", msg.Editor, msg.Id)
+	}
+
+	val.Editors = slices.DeleteFunc(val.Editors, func(editor string) bool {
+		return editor == msg.Editor
+	})
+	k.SetPost(ctx, val)
+	return &types.MsgRemoveEditorResponse{}, nil
+}
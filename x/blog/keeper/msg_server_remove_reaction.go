@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) RemoveReaction(goCtx context.Context, msg *types.MsgRemoveReaction) (*types.MsgRemoveReactionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetReaction(ctx, msg.PostId, msg.Creator, msg.Kind); !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("no %s reaction on post %d", msg.Kind, msg.PostId))
+	}
+	k.Keeper.RemoveReaction(ctx, msg.PostId, msg.Creator, msg.Kind)
+	return &types.MsgRemoveReactionResponse{}, nil
+}
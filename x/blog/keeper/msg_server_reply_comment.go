@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) ReplyComment(goCtx context.Context, msg *types.MsgReplyComment) (*types.MsgReplyCommentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotBlocked(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.checkCommentablePost(ctx, msg.PostId); err != nil {
+		return nil, err
+	}
+	parent, found := k.GetComment(ctx, msg.PostId, msg.ParentId)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("comment %d on post %d doesn't exist", msg.ParentId, msg.PostId))
+	}
+	if parent.Deleted {
+		return nil, errorsmod.Wrapf(types.ErrCommentDeleted, "comment %d on post %d", msg.ParentId, msg.PostId)
+	}
+	depth := parent.Depth + 1
+	if maxDepth := k.GetParams(ctx).MaxCommentDepth; depth > maxDepth {
+		return nil, errorsmod.Wrapf(types.ErrMaxCommentDepth, "reply depth %d exceeds %d", depth, maxDepth)
+	}
+	var comment = types.Comment{
+		PostId:        msg.PostId,
+		Creator:       msg.Creator,
+		Body:          msg.Body,
+		CreatedAt:     ctx.BlockTime(),
+		CreatedHeight: ctx.BlockHeight(),
+		UpdatedAt:     ctx.BlockTime(),
+		UpdatedHeight: ctx.BlockHeight(),
+		ParentId:      parent.Id,
+		Depth:         depth,
+	}
+	id := k.AppendComment(
+		ctx,
+		comment,
+	)
+	return &types.MsgReplyCommentResponse{
+		Id: id,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) ReportPost(goCtx context.Context, msg *types.MsgReportPost) (*types.MsgReportPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotBlocked(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	val, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+	if val.Deleted {
+		return nil, errorsmod.Wrapf(types.ErrPostDeleted, "post %d", msg.Id)
+	}
+	// announcements are moderated by governance alone
+	if val.Official {
+		return nil, errorsmod.Wrapf(types.ErrOfficialPost, "post %d cannot be reported", msg.Id)
+	}
+	if _, found := k.GetReport(ctx, msg.Id, msg.Creator); found {
+		return nil, errorsmod.Wrapf(types.ErrAlreadyReported, "post %d by %s", msg.Id, msg.Creator)
+	}
+
+	hidden := k.RecordReport(ctx, val, types.Report{
+		PostId:        msg.Id,
+		Reporter:      msg.Creator,
+		Reason:        msg.Reason,
+		CreatedAt:     ctx.BlockTime(),
+		CreatedHeight: ctx.BlockHeight(),
+	})
+	return &types.MsgReportPostResponse{Hidden: hidden}, nil
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) RestorePost(goCtx context.Context, msg *types.MsgRestorePost) (*types.MsgRestorePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotBlocked(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	val, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+	if !val.Deleted {
+		return nil, errorsmod.Wrapf(types.ErrPostNotDeleted, "post %d", msg.Id)
+	}
+	deletedBy := val.DeletedBy
+	if deletedBy == "" {
+		deletedBy = val.Creator
+	}
+	if err := checkOwner(msg.Creator, deletedBy); err != nil {
+		return nil, err
+	}
+	if !ctx.BlockTime().Before(val.PurgeAt) {
+		return nil, errorsmod.Wrapf(types.ErrRestoreWindowExpired, "post %d was purgeable since %s", msg.Id, val.PurgeAt)
+	}
+	deposit := k.PostDeposit(ctx, val.Title, val.Body)
+	if err := k.AdjustPostDeposit(ctx, msg.Creator, val.Deposit, deposit); err != nil {
+		return nil, err
+	}
+	if err := k.MintPostNFT(ctx, val.Id, msg.Creator); err != nil {
+		return nil, err
+	}
+	val.Creator = msg.Creator
+	val.Deposit = deposit
+	k.ClearPostTombstone(ctx, val)
+	return &types.MsgRestorePostResponse{}, nil
+}
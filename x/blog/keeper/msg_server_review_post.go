@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) ReviewPost(goCtx context.Context, msg *types.MsgReviewPost) (*types.MsgReviewPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if msg.Creator != k.GetAuthority() {
+		if err := k.checkModerator(ctx, msg.Creator); err != nil {
+			return nil, err
+		}
+	}
+	val, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+	if !k.IsPostInReview(ctx, msg.Id) {
+		return nil, errorsmod.Wrapf(types.ErrPostNotInReview, "post %d", msg.Id)
+	}
+
+	k.RemoveFromPostReviewQueue(ctx, msg.Id)
+	if msg.Restore {
+		val.Hidden = false
+		val.HideReason = types.HideReason_HIDE_REASON_UNSPECIFIED
+		val.HiddenBy = ""
+		k.SetPost(ctx, val)
+		k.RemovePostReports(ctx, msg.Id)
+	} else {
+		val.HiddenBy = msg.Creator
+		k.SetPost(ctx, val)
+	}
+	return &types.MsgReviewPostResponse{}, nil
+}
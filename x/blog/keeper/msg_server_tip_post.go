@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) TipPost(goCtx context.Context, msg *types.MsgTipPost) (*types.MsgTipPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotBlocked(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.checkCommentablePost(ctx, msg.PostId); err != nil {
+		return nil, err
+	}
+	post, _ := k.GetPost(ctx, msg.PostId)
+	// tips are paid to whoever holds the post NFT now
+	post = k.syncPostOwner(ctx, post)
+	// announcements are not paid to governance
+	if post.Official {
+		return nil, errorsmod.Wrapf(types.ErrOfficialPost, "post %d cannot be tipped", msg.PostId)
+	}
+	if post.Creator == msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot tip your own post")
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	recipient, err := sdk.AccAddressFromBech32(post.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid post creator address (%s)", err)
+	}
+	if err := k.bankKeeper.SendCoins(ctx, sender, recipient, msg.Amount); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to send tip")
+	}
+	k.RecordPostTip(ctx, post, msg.Creator, msg.Amount)
+
+	return &types.MsgTipPostResponse{}, nil
+}
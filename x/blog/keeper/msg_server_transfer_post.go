@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) TransferPost(goCtx context.Context, msg *types.MsgTransferPost) (*types.MsgTransferPostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotBlocked(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	if err := k.checkNotBlocked(ctx, msg.NewOwner); err != nil {
+		return nil, err
+	}
+	val, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+	if err := checkOwner(msg.Creator, k.PostOwner(ctx, val)); err != nil {
+		return nil, err
+	}
+	if val.Deleted {
+		return nil, errorsmod.Wrapf(types.ErrPostDeleted, "post %d", msg.Id)
+	}
+	// announcements stay with governance
+	if val.Official {
+		return nil, errorsmod.Wrapf(types.ErrOfficialPost, "post %d cannot be transferred", msg.Id)
+	}
+
+	if msg.Offer {
+		val.Creator = msg.Creator
+		val.PendingOwner = msg.NewOwner
+		k.SetPost(ctx, val)
+		return &types.MsgTransferPostResponse{}, nil
+	}
+	if err := k.TransferPostOwnership(ctx, val, msg.NewOwner); err != nil {
+		return nil, err
+	}
+	return &types.MsgTransferPostResponse{}, nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) UnhidePost(goCtx context.Context, msg *types.MsgUnhidePost) (*types.MsgUnhidePostResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkModerator(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	val, found := k.GetPost(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
+	}
+	if !val.Hidden {
+		return nil, errorsmod.Wrapf(types.ErrPostNotHidden, "post %d", msg.Id)
+	}
+
+	val.Hidden = false
+	val.HideReason = types.HideReason_HIDE_REASON_UNSPECIFIED
+	val.HiddenBy = ""
+	k.SetPost(ctx, val)
+	k.RemoveFromPostReviewQueue(ctx, msg.Id)
+	k.RemovePostReports(ctx, msg.Id)
+	return &types.MsgUnhidePostResponse{}, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) UpdateComment(goCtx context.Context, msg *types.MsgUpdateComment) (*types.MsgUpdateCommentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.checkNotBlocked(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+	val, found := k.GetComment(ctx, msg.PostId, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("comment %d on post %d doesn't exist", msg.Id, msg.PostId))
+	}
+	if err := checkOwner(msg.Creator, val.Creator); err != nil {
+		return nil, err
+	}
+	if val.Deleted {
+		return nil, errorsmod.Wrapf(types.ErrCommentDeleted, "comment %d on post %d", msg.Id, msg.PostId)
+	}
+	if post, _ := k.GetPost(ctx, msg.PostId); post.Deleted {
+		return nil, errorsmod.Wrapf(types.ErrPostDeleted, "post %d", msg.PostId)
+	}
+	val.Body = msg.Body
+	val.UpdatedAt = ctx.BlockTime()
+	val.UpdatedHeight = ctx.BlockHeight()
+	k.SetComment(ctx, val)
+	return &types.MsgUpdateCommentResponse{}, nil
+}
@@ -13,19 +13,85 @@ import (
 
 func (k msgServer) UpdatePost(goCtx context.Context, msg *types.MsgUpdatePost) (*types.MsgUpdatePostResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	var post = types.Post{
-		Creator: msg.Creator,
-		Id:      msg.Id,
-		Title:   msg.Title,
-		Body:    msg.Body,
+	if err := k.checkNotBlocked(ctx, msg.Creator); err != nil {
+		return nil, err
 	}
 	val, found := k.GetPost(ctx, msg.Id)
 	if !found {
 		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", msg.Id))
 	}
-	if msg.Creator != val.Creator {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
+	synced := k.syncPostOwner(ctx, val)
+	owner := synced.Creator
+	if !isPostEditor(synced, msg.Creator) {
+		if err := checkOwner(msg.Creator, owner); err != nil {
+			return nil, err
+		}
+	}
+	if val.Deleted {
+		return nil, errorsmod.Wrapf(types.ErrPostDeleted, "post %d", msg.Id)
+	}
+	tags, err := k.checkPostContent(ctx, msg.Title, msg.Body, msg.Tags)
+	if err != nil {
+		return nil, err
+	}
+	deposit := k.PostDeposit(ctx, msg.Title, msg.Body)
+	if synced.Official {
+		// announcements are paid for by nobody and hold no deposit
+		deposit = NoPostDeposit(deposit)
+	}
+	// the deposit is held for the owner, who pays for any growth and gets
+	// any refund even when an editor made the change
+	if err := k.AdjustPostDeposit(ctx, owner, val.Deposit, deposit); err != nil {
+		return nil, err
+	}
+	k.savePostRevision(ctx, val)
+
+	var post = types.Post{
+		Creator:        owner,
+		OriginalAuthor: val.OriginalAuthor,
+		Id:             msg.Id,
+		Title:          msg.Title,
+		Body:           msg.Body,
+		Tags:           tags,
+		Status:         val.Status,
+		PublishAt:      val.PublishAt,
+		Deposit:        deposit,
+		Tips:           val.Tips,
+		PendingOwner:   synced.PendingOwner,
+		Editors:        synced.Editors,
+		Hidden:         val.Hidden,
+		HideReason:     val.HideReason,
+		HiddenBy:       val.HiddenBy,
+		Official:       synced.Official,
+		CreatedAt:      val.CreatedAt,
+		CreatedHeight:  val.CreatedHeight,
+		UpdatedAt:      ctx.BlockTime(),
+		UpdatedHeight:  ctx.BlockHeight(),
+		Revision:       val.Revision + 1,
 	}
 	k.SetPost(ctx, post)
 	return &types.MsgUpdatePostResponse{}, nil
 }
+
+// savePostRevision stores the current version of a post before it is
+// overwritten and prunes revisions beyond the MaxRevisions param.
+func (k msgServer) savePostRevision(ctx sdk.Context, post types.Post) {
+	maxRevisions := k.GetParams(ctx).MaxRevisions
+	if maxRevisions > 0 {
+		k.SetPostRevision(ctx, types.PostRevision{
+			PostId:        post.Id,
+			Revision:      post.Revision,
+			Title:         post.Title,
+			Body:          post.Body,
+			Tags:          post.Tags,
+			Creator:       post.Creator,
+			CreatedAt:     post.UpdatedAt,
+			CreatedHeight: post.UpdatedHeight,
+		})
+	}
+
+	next := post.Revision + 1
+	if next > maxRevisions {
+		k.PrunePostRevisions(ctx, post.Id, next-maxRevisions)
+	}
+}
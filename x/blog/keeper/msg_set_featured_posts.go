@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) SetFeaturedPosts(goCtx context.Context, req *types.MsgSetFeaturedPosts) (*types.MsgSetFeaturedPostsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, elem := range req.FeaturedPosts {
+		post, found := k.GetPost(ctx, elem.PostId)
+		if !found {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, fmt.Sprintf("key %d doesn't exist", elem.PostId))
+		}
+		if post.Deleted {
+			return nil, errorsmod.Wrapf(types.ErrPostDeleted, "post %d", elem.PostId)
+		}
+		if post.Hidden {
+			return nil, errorsmod.Wrapf(types.ErrPostHidden, "post %d", elem.PostId)
+		}
+		if post.Status != types.PostStatus_POST_STATUS_PUBLISHED {
+			return nil, errorsmod.Wrapf(types.ErrInvalidPostStatus, "post %d is not published", elem.PostId)
+		}
+		if elem.ExpiresAt != nil && !elem.ExpiresAt.After(ctx.BlockTime()) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidExpiry, "expiry %s of post %d is not in the future", elem.ExpiresAt, elem.PostId)
+		}
+	}
+
+	k.ReplaceFeaturedPosts(ctx, req.FeaturedPosts)
+
+	return &types.MsgSetFeaturedPostsResponse{}, nil
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+func (k msgServer) UpdateBlocklist(goCtx context.Context, req *types.MsgUpdateBlocklist) (*types.MsgUpdateBlocklistResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, address := range req.Unblock {
+		k.UnblockAddress(ctx, address)
+	}
+
+	var hidden uint64
+	for _, address := range req.Block {
+		k.BlockAddress(ctx, address)
+		if req.HidePosts {
+			hidden += k.HideCreatorPosts(ctx, address, types.HideReason_HIDE_REASON_BLOCKED, req.Authority)
+		}
+	}
+
+	return &types.MsgUpdateBlocklistResponse{Hidden: hidden}, nil
+}
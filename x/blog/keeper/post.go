@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 
 	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -17,6 +18,11 @@ func (k Keeper) AppendPost(ctx sdk.Context, post types.Post) uint64 {
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
 	appendedValue := k.cdc.MustMarshal(&post)
 	store.Set(GetPostIDBytes(post.Id), appendedValue)
+	k.setPostCreatorIndex(ctx, post.Creator, post.Id)
+	k.setPostAnnouncementIndex(ctx, post)
+	k.reindexPostEditors(ctx, post.Id, nil, post.Editors)
+	k.reindexPostTags(ctx, post.Id, nil, indexedPostTags(post))
+	k.reindexPostTips(ctx, post.Id, nil, post.Tips)
 	k.SetPostCount(ctx, count+1)
 	return count
 }
@@ -58,14 +64,64 @@ func (k Keeper) GetPost(ctx sdk.Context, id uint64) (val types.Post, found bool)
 	return val, true
 }
 func (k Keeper) SetPost(ctx sdk.Context, post types.Post) {
+	prev, found := k.GetPost(ctx, post.Id)
+	if found && prev.Creator != post.Creator {
+		k.removePostCreatorIndex(ctx, prev.Creator, prev.Id)
+	}
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
 	b := k.cdc.MustMarshal(&post)
 	store.Set(GetPostIDBytes(post.Id), b)
+	k.setPostCreatorIndex(ctx, post.Creator, post.Id)
+	k.setPostAnnouncementIndex(ctx, post)
+	k.reindexPostEditors(ctx, post.Id, prev.Editors, post.Editors)
+	k.reindexPostTags(ctx, post.Id, indexedPostTags(prev), indexedPostTags(post))
+	k.reindexPostTips(ctx, post.Id, prev.Tips, post.Tips)
 }
 
 func (k Keeper) RemovePost(ctx sdk.Context, id uint64) {
+	if prev, found := k.GetPost(ctx, id); found {
+		k.removePostCreatorIndex(ctx, prev.Creator, prev.Id)
+		k.removePostAnnouncementIndex(ctx, prev.Id)
+		k.reindexPostEditors(ctx, prev.Id, prev.Editors, nil)
+		k.reindexPostTags(ctx, prev.Id, indexedPostTags(prev), nil)
+		k.reindexPostTips(ctx, prev.Id, prev.Tips, nil)
+	}
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
 	store.Delete(GetPostIDBytes(id))
 }
+
+// GetPostCreatorKeyPrefix returns the store prefix indexing the posts of a creator.
+func GetPostCreatorKeyPrefix(creator string) []byte {
+	key := append(types.KeyPrefix(types.PostCreatorKey), []byte(creator)...)
+	return append(key, []byte("/")...)
+}
+
+func (k Keeper) setPostCreatorIndex(ctx sdk.Context, creator string, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostCreatorKeyPrefix(creator))
+	store.Set(GetPostIDBytes(id), []byte{})
+}
+
+func (k Keeper) removePostCreatorIndex(ctx sdk.Context, creator string, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostCreatorKeyPrefix(creator))
+	store.Delete(GetPostIDBytes(id))
+}
+
+func (k Keeper) GetAllPost(ctx sdk.Context) (list []types.Post) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Post
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
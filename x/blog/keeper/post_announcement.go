@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// setPostAnnouncementIndex indexes a post as an announcement if it is
+// official, and drops it from the index otherwise.
+func (k Keeper) setPostAnnouncementIndex(ctx sdk.Context, post types.Post) {
+	if !post.Official {
+		k.removePostAnnouncementIndex(ctx, post.Id)
+		return
+	}
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostAnnouncementKey))
+	store.Set(GetPostIDBytes(post.Id), []byte{})
+}
+
+func (k Keeper) removePostAnnouncementIndex(ctx sdk.Context, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostAnnouncementKey))
+	store.Delete(GetPostIDBytes(id))
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "blog/testutil/keeper"
+	"blog/testutil/sample"
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+)
+
+func TestCreateAnnouncement(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	authority := k.GetAuthority()
+	announcements := func() []types.Post {
+		res, err := k.ListAnnouncements(ctx, &types.QueryListAnnouncementsRequest{})
+		require.NoError(t, err)
+		return res.Post
+	}
+
+	_, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: sample.AccAddress(), Title: "title", Body: "body"})
+	require.NoError(t, err)
+	_, err = ms.CreateAnnouncement(ctx, &types.MsgCreateAnnouncement{Authority: sample.AccAddress(), Title: "upgrade", Body: "body"})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	require.Empty(t, announcements())
+
+	res, err := ms.CreateAnnouncement(ctx, &types.MsgCreateAnnouncement{Authority: authority, Title: "upgrade", Body: "body", Tags: []string{"Upgrade"}})
+	require.NoError(t, err)
+	post, found := k.GetPost(sdk.UnwrapSDKContext(ctx), res.Id)
+	require.True(t, found)
+	require.True(t, post.Official)
+	require.Equal(t, authority, post.Creator)
+	require.Equal(t, authority, post.OriginalAuthor)
+	require.Equal(t, types.PostStatus_POST_STATUS_PUBLISHED, post.Status)
+	require.Equal(t, []string{"upgrade"}, post.Tags)
+	require.Equal(t, authority, k.PostOwner(sdk.UnwrapSDKContext(ctx), post))
+
+	listed := announcements()
+	require.Len(t, listed, 1)
+	require.Equal(t, res.Id, listed[0].Id)
+
+	// announcements can neither change hands nor take editors
+	_, err = ms.TransferPost(ctx, &types.MsgTransferPost{Creator: authority, Id: res.Id, NewOwner: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrOfficialPost)
+	_, err = ms.TransferPost(ctx, &types.MsgTransferPost{Creator: authority, Id: res.Id, NewOwner: sample.AccAddress(), Offer: true})
+	require.ErrorIs(t, err, types.ErrOfficialPost)
+	_, err = ms.AddEditor(ctx, &types.MsgAddEditor{Creator: authority, Id: res.Id, Editor: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrOfficialPost)
+
+	// nor can they be tipped, reported or hidden
+	tip := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	_, err = ms.TipPost(ctx, &types.MsgTipPost{Creator: sample.AccAddress(), PostId: res.Id, Amount: tip})
+	require.ErrorIs(t, err, types.ErrOfficialPost)
+	require.ErrorIs(t, k.ForwardPostTip(sdk.UnwrapSDKContext(ctx), post, sample.AccAddress(), tip), types.ErrOfficialPost)
+	_, err = ms.ReportPost(ctx, &types.MsgReportPost{Creator: sample.AccAddress(), Id: res.Id, Reason: types.HideReason_HIDE_REASON_SPAM})
+	require.ErrorIs(t, err, types.ErrOfficialPost)
+	moderator := sample.AccAddress()
+	params := k.GetParams(sdk.UnwrapSDKContext(ctx))
+	params.Moderators = []string{moderator}
+	params.ReportThreshold = 1
+	require.NoError(t, k.SetParams(sdk.UnwrapSDKContext(ctx), params))
+	_, err = ms.HidePost(ctx, &types.MsgHidePost{Creator: moderator, Id: res.Id, Reason: types.HideReason_HIDE_REASON_SPAM})
+	require.ErrorIs(t, err, types.ErrOfficialPost)
+	hidden := k.RecordReport(sdk.UnwrapSDKContext(ctx), post, types.Report{PostId: res.Id, Reporter: sample.AccAddress(), Reason: types.HideReason_HIDE_REASON_SPAM})
+	require.False(t, hidden)
+	post, _ = k.GetPost(sdk.UnwrapSDKContext(ctx), res.Id)
+	require.False(t, post.Hidden)
+
+	// updates keep the post official
+	_, err = ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: authority, Id: res.Id, Title: "upgrade v2", Body: "body"})
+	require.NoError(t, err)
+	post, _ = k.GetPost(sdk.UnwrapSDKContext(ctx), res.Id)
+	require.True(t, post.Official)
+	require.Len(t, announcements(), 1)
+
+	_, err = ms.DeletePost(ctx, &types.MsgDeletePost{Creator: authority, Id: res.Id})
+	require.NoError(t, err)
+	require.Empty(t, announcements())
+}
+
+func TestAnnouncementLeavingGovernance(t *testing.T) {
+	k, bank, nftKeeper, ctx := keepertest.BlogKeeperWithMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	authority, holder := k.GetAuthority(), sample.AccAddress()
+	params := k.GetParams(ctx)
+	params.DepositPerByte = sdk.NewInt64Coin("stake", 1)
+	require.NoError(t, k.SetParams(ctx, params))
+	bank.Fund(sdk.MustAccAddressFromBech32(holder), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	res, err := ms.CreateAnnouncement(ctx, &types.MsgCreateAnnouncement{Authority: authority, Title: "upgrade", Body: "body"})
+	require.NoError(t, err)
+
+	// the NFT is sent through x/nft, so the post is no longer governance's
+	err = nftKeeper.Transfer(ctx, types.PostNFTClassID, types.PostNFTID(res.Id), sdk.MustAccAddressFromBech32(holder))
+	require.NoError(t, err)
+	_, err = ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: holder, Id: res.Id, Title: "upgrade", Body: "body"})
+	require.NoError(t, err)
+	post, _ := k.GetPost(ctx, res.Id)
+	require.False(t, post.Official)
+	require.Equal(t, holder, post.Creator)
+	require.Equal(t, sdk.NewInt64Coin("stake", 11), post.Deposit)
+	listed, err := k.ListAnnouncements(ctx, &types.QueryListAnnouncementsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, listed.Post)
+}
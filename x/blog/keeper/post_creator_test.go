@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "blog/testutil/keeper"
+	"blog/testutil/sample"
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+)
+
+func TestListPostsByCreator(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	alice, bob := sample.AccAddress(), sample.AccAddress()
+
+	var aliceIDs []uint64
+	for i := 0; i < 3; i++ {
+		res, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: alice, Title: "title", Body: "body"})
+		require.NoError(t, err)
+		aliceIDs = append(aliceIDs, res.Id)
+	}
+	bobRes, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: bob, Title: "title", Body: "body"})
+	require.NoError(t, err)
+
+	res, err := k.ListPostsByCreator(sdkCtx, &types.QueryListPostsByCreatorRequest{Creator: alice})
+	require.NoError(t, err)
+	require.Len(t, res.Post, 3)
+	for i, post := range res.Post {
+		require.Equal(t, aliceIDs[i], post.Id)
+		require.Equal(t, alice, post.Creator)
+	}
+
+	res, err = k.ListPostsByCreator(sdkCtx, &types.QueryListPostsByCreatorRequest{
+		Creator:    alice,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Post, 2)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	// deleted posts are hidden unless explicitly requested
+	_, err = ms.DeletePost(sdkCtx, &types.MsgDeletePost{Creator: alice, Id: aliceIDs[0]})
+	require.NoError(t, err)
+	res, err = k.ListPostsByCreator(sdkCtx, &types.QueryListPostsByCreatorRequest{Creator: alice})
+	require.NoError(t, err)
+	require.Len(t, res.Post, 2)
+	res, err = k.ListPostsByCreator(sdkCtx, &types.QueryListPostsByCreatorRequest{Creator: alice, IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, res.Post, 3)
+
+	// drafts and unlisted posts are not listed
+	for _, status := range []types.PostStatus{types.PostStatus_POST_STATUS_DRAFT, types.PostStatus_POST_STATUS_UNLISTED} {
+		_, err = ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: bob, Title: "title", Body: "body", Status: status})
+		require.NoError(t, err)
+	}
+	res, err = k.ListPostsByCreator(sdkCtx, &types.QueryListPostsByCreatorRequest{Creator: bob, IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, res.Post, 1)
+
+	// the index follows a change of creator and is cleared on removal
+	_, err = ms.TransferPost(sdkCtx, &types.MsgTransferPost{Creator: bob, Id: bobRes.Id, NewOwner: alice})
+	require.NoError(t, err)
+	res, err = k.ListPostsByCreator(sdkCtx, &types.QueryListPostsByCreatorRequest{Creator: bob})
+	require.NoError(t, err)
+	require.Empty(t, res.Post)
+	res, err = k.ListPostsByCreator(sdkCtx, &types.QueryListPostsByCreatorRequest{Creator: alice})
+	require.NoError(t, err)
+	require.Len(t, res.Post, 3)
+
+	k.RemovePost(sdkCtx, bobRes.Id)
+	res, err = k.ListPostsByCreator(sdkCtx, &types.QueryListPostsByCreatorRequest{Creator: alice})
+	require.NoError(t, err)
+	require.Len(t, res.Post, 2)
+
+	_, err = k.ListPostsByCreator(sdkCtx, &types.QueryListPostsByCreatorRequest{Creator: "invalid"})
+	require.Error(t, err)
+	_, err = k.ListPostsByCreator(sdkCtx, nil)
+	require.Error(t, err)
+}
+
+func TestCreatorPostsAfterNFTSend(t *testing.T) {
+	k, _, nftKeeper, ctx := keepertest.BlogKeeperWithMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	author, buyer, newAuthor := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	listed := func(creator string) []uint64 {
+		res, err := k.ListPostsByCreator(ctx, &types.QueryListPostsByCreatorRequest{Creator: creator})
+		require.NoError(t, err)
+		var ids []uint64
+		for _, post := range res.Post {
+			ids = append(ids, post.Id)
+		}
+		return ids
+	}
+
+	var ids []uint64
+	for i := 0; i < 3; i++ {
+		res, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: author, Title: "title", Body: "body"})
+		require.NoError(t, err)
+		ids = append(ids, res.Id)
+	}
+	// two NFTs are sent through x/nft, behind the blog module's back
+	for _, id := range ids[:2] {
+		err := nftKeeper.Transfer(ctx, types.PostNFTClassID, types.PostNFTID(id), sdk.MustAccAddressFromBech32(buyer))
+		require.NoError(t, err)
+	}
+	require.Equal(t, ids[2:], listed(author))
+
+	// the author hands their posts over, which leaves the sold ones alone
+	res, err := ms.MigrateAuthor(ctx, &types.MsgMigrateAuthor{OldAuthor: author, NewAuthor: newAuthor})
+	require.NoError(t, err)
+	require.True(t, res.Done)
+	require.Equal(t, ids[2:], listed(newAuthor))
+	require.Equal(t, ids[:2], listed(buyer))
+	post, _ := k.GetPost(ctx, ids[0])
+	require.Equal(t, buyer, post.Creator)
+
+	// one more NFT is sent; blocking the buyer hides every post they hold
+	err = nftKeeper.Transfer(ctx, types.PostNFTClassID, types.PostNFTID(ids[2]), sdk.MustAccAddressFromBech32(buyer))
+	require.NoError(t, err)
+	_, err = ms.UpdateBlocklist(ctx, &types.MsgUpdateBlocklist{Authority: k.GetAuthority(), Block: []string{buyer}, HidePosts: true})
+	require.NoError(t, err)
+	for _, id := range ids {
+		post, _ := k.GetPost(ctx, id)
+		require.True(t, post.Hidden)
+		require.Equal(t, buyer, post.Creator)
+	}
+	require.Empty(t, listed(newAuthor))
+}
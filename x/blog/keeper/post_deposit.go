@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+// PostDeposit returns the storage deposit owed for a post with the given
+// title and body under the current params.
+func (k Keeper) PostDeposit(ctx sdk.Context, title, body string) sdk.Coin {
+	perByte := k.GetParams(ctx).DepositPerByte
+	return sdk.NewCoin(perByte.Denom, perByte.Amount.MulRaw(int64(len(title)+len(body))))
+}
+
+// NoPostDeposit returns an empty deposit in the denom of held.
+func NoPostDeposit(held sdk.Coin) sdk.Coin {
+	return sdk.Coin{Denom: held.Denom, Amount: math.ZeroInt()}
+}
+
+// AdjustPostDeposit moves funds between the owner of a post and the module
+// account so that the deposit held for the post goes from held to owed.
+func (k Keeper) AdjustPostDeposit(ctx sdk.Context, owner string, held, owed sdk.Coin) error {
+	var charge, refund sdk.Coins
+	switch {
+	case held.Amount.IsNil() || !held.IsPositive():
+		charge = depositCoins(owed)
+	case held.Denom == owed.Denom:
+		diff := owed.Amount.Sub(held.Amount)
+		if diff.IsPositive() {
+			charge = sdk.NewCoins(sdk.NewCoin(owed.Denom, diff))
+		} else {
+			refund = sdk.NewCoins(sdk.NewCoin(held.Denom, diff.Neg()))
+		}
+	default:
+		charge = depositCoins(owed)
+		refund = depositCoins(held)
+	}
+
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	if !charge.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, ownerAddr, types.ModuleName, charge); err != nil {
+			return errorsmod.Wrap(err, "failed to escrow post deposit")
+		}
+	}
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, ownerAddr, refund); err != nil {
+			return errorsmod.Wrap(err, "failed to refund post deposit")
+		}
+	}
+	return nil
+}
+
+func depositCoins(deposit sdk.Coin) sdk.Coins {
+	if deposit.Amount.IsNil() || !deposit.IsPositive() {
+		return nil
+	}
+	return sdk.NewCoins(deposit)
+}
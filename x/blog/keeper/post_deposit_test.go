@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "blog/testutil/keeper"
+	"blog/testutil/sample"
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+)
+
+func TestPostDeposit(t *testing.T) {
+	k, bank, ctx := keepertest.BlogKeeperWithBank(t)
+	ctx = ctx.WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	ms := keeper.NewMsgServerImpl(k)
+	creator := sample.AccAddress()
+	creatorAddr := sdk.MustAccAddressFromBech32(creator)
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+
+	params := types.DefaultParams()
+	params.DepositPerByte = sdk.NewInt64Coin("stake", 2)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// 5 bytes of title and 5 bytes of body
+	_, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: creator, Title: "title", Body: "hello"})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	bank.Fund(creatorAddr, stake(100))
+	res, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: creator, Title: "title", Body: "hello"})
+	require.NoError(t, err)
+	show, err := k.ShowPost(ctx, &types.QueryShowPostRequest{Id: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 20), show.Post.Deposit)
+	require.Equal(t, stake(20), bank.ModuleBalance(types.ModuleName))
+
+	// a longer post tops the deposit up and a shorter one refunds the difference
+	_, err = ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: creator, Id: res.Id, Title: "title", Body: "hello world"})
+	require.NoError(t, err)
+	require.Equal(t, stake(32), bank.ModuleBalance(types.ModuleName))
+	_, err = ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: creator, Id: res.Id, Title: "t", Body: "b"})
+	require.NoError(t, err)
+	require.Equal(t, stake(4), bank.ModuleBalance(types.ModuleName))
+	require.Equal(t, stake(96), bank.SpendableCoins(ctx, creatorAddr))
+
+	// deleting refunds the whole deposit and restoring escrows it again
+	_, err = ms.DeletePost(ctx, &types.MsgDeletePost{Creator: creator, Id: res.Id})
+	require.NoError(t, err)
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+	require.Equal(t, stake(100), bank.SpendableCoins(ctx, creatorAddr))
+	post, _ := k.GetPost(ctx, res.Id)
+	require.True(t, post.Deposit.IsZero())
+
+	_, err = ms.RestorePost(ctx, &types.MsgRestorePost{Creator: creator, Id: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, stake(4), bank.ModuleBalance(types.ModuleName))
+	post, _ = k.GetPost(ctx, res.Id)
+	require.Equal(t, sdk.NewInt64Coin("stake", 4), post.Deposit)
+
+	// the owner pays for and is refunded the changes of an editor
+	editor := sample.AccAddress()
+	editorAddr := sdk.MustAccAddressFromBech32(editor)
+	bank.Fund(editorAddr, stake(100))
+	_, err = ms.AddEditor(ctx, &types.MsgAddEditor{Creator: creator, Id: res.Id, Editor: editor})
+	require.NoError(t, err)
+	_, err = ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: editor, Id: res.Id, Title: "title", Body: "hello"})
+	require.NoError(t, err)
+	require.Equal(t, stake(20), bank.ModuleBalance(types.ModuleName))
+	require.Equal(t, stake(80), bank.SpendableCoins(ctx, creatorAddr))
+	_, err = ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: editor, Id: res.Id, Title: "t", Body: "b"})
+	require.NoError(t, err)
+	require.Equal(t, stake(96), bank.SpendableCoins(ctx, creatorAddr))
+	require.Equal(t, stake(100), bank.SpendableCoins(ctx, editorAddr))
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"slices"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// GetPostEditorKeyPrefix returns the store prefix indexing the posts an
+// account is an editor of.
+func GetPostEditorKeyPrefix(editor string) []byte {
+	key := append(types.KeyPrefix(types.PostEditorKey), []byte(editor)...)
+	return append(key, []byte("/")...)
+}
+
+// isPostEditor returns whether an account is an editor of a post.
+func isPostEditor(post types.Post, account string) bool {
+	return slices.Contains(post.Editors, account)
+}
+
+// reindexPostEditors updates the editor index of a post whose editors changed
+// from oldEditors to newEditors.
+func (k Keeper) reindexPostEditors(ctx sdk.Context, id uint64, oldEditors, newEditors []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	for _, editor := range oldEditors {
+		if !slices.Contains(newEditors, editor) {
+			store := prefix.NewStore(storeAdapter, GetPostEditorKeyPrefix(editor))
+			store.Delete(GetPostIDBytes(id))
+		}
+	}
+	for _, editor := range newEditors {
+		if !slices.Contains(oldEditors, editor) {
+			store := prefix.NewStore(storeAdapter, GetPostEditorKeyPrefix(editor))
+			store.Set(GetPostIDBytes(id), []byte{})
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func TestPostEditors(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	owner, editor, other := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	editable := func(account string) []types.Post {
+		res, err := k.ListPostsByEditor(ctx, &types.QueryListPostsByEditorRequest{Editor: account})
+		require.NoError(t, err)
+		return res.Post
+	}
+
+	created, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: owner, Title: "title", Body: "body"})
+	require.NoError(t, err)
+	id := created.Id
+
+	_, err = ms.AddEditor(ctx, &types.MsgAddEditor{Creator: other, Id: id, Editor: editor})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.AddEditor(ctx, &types.MsgAddEditor{Creator: owner, Id: id, Editor: owner})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = ms.AddEditor(ctx, &types.MsgAddEditor{Creator: owner, Id: id, Editor: editor})
+	require.NoError(t, err)
+	_, err = ms.AddEditor(ctx, &types.MsgAddEditor{Creator: owner, Id: id, Editor: editor})
+	require.ErrorIs(t, err, types.ErrEditorExists)
+
+	show, err := k.ShowPost(ctx, &types.QueryShowPostRequest{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, []string{editor}, show.Post.Editors)
+	require.Len(t, editable(editor), 1)
+	require.Empty(t, editable(other))
+
+	// editors can update the post but not delete it
+	_, err = ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: editor, Id: id, Title: "edited", Body: "body"})
+	require.NoError(t, err)
+	post, _ := k.GetPost(sdk.UnwrapSDKContext(ctx), id)
+	require.Equal(t, "edited", post.Title)
+	require.Equal(t, owner, post.Creator)
+	require.Equal(t, []string{editor}, post.Editors)
+	_, err = ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: other, Id: id, Title: "edited", Body: "body"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.DeletePost(ctx, &types.MsgDeletePost{Creator: editor, Id: id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// deleted posts are only listed on request, like the posts of a creator
+	deleted, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: owner, Title: "title", Body: "body"})
+	require.NoError(t, err)
+	_, err = ms.AddEditor(ctx, &types.MsgAddEditor{Creator: owner, Id: deleted.Id, Editor: editor})
+	require.NoError(t, err)
+	_, err = ms.DeletePost(ctx, &types.MsgDeletePost{Creator: owner, Id: deleted.Id})
+	require.NoError(t, err)
+	require.Len(t, editable(editor), 1)
+	res, err := k.ListPostsByEditor(ctx, &types.QueryListPostsByEditorRequest{Editor: editor, IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, res.Post, 2)
+
+	// drafts and unlisted posts are not listed either
+	for _, status := range []types.PostStatus{types.PostStatus_POST_STATUS_DRAFT, types.PostStatus_POST_STATUS_UNLISTED} {
+		unpublished, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: owner, Title: "title", Body: "body", Status: status})
+		require.NoError(t, err)
+		_, err = ms.AddEditor(ctx, &types.MsgAddEditor{Creator: owner, Id: unpublished.Id, Editor: editor})
+		require.NoError(t, err)
+	}
+	require.Len(t, editable(editor), 1)
+
+	_, err = ms.RemoveEditor(ctx, &types.MsgRemoveEditor{Creator: editor, Id: id, Editor: editor})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.RemoveEditor(ctx, &types.MsgRemoveEditor{Creator: owner, Id: id, Editor: other})
+	require.ErrorIs(t, err, types.ErrEditorNotFound)
+	_, err = ms.RemoveEditor(ctx, &types.MsgRemoveEditor{Creator: owner, Id: id, Editor: editor})
+	require.NoError(t, err)
+	require.Empty(t, editable(editor))
+	_, err = ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: editor, Id: id, Title: "again", Body: "body"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
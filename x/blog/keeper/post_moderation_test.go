@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func TestHidePost(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	owner, moderator, editor := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	params := k.GetParams(ctx)
+	params.Moderators = []string{moderator}
+	require.NoError(t, k.SetParams(ctx, params))
+	listed := func() []types.Post {
+		res, err := k.ListPost(ctx, &types.QueryListPostRequest{})
+		require.NoError(t, err)
+		return res.Post
+	}
+
+	created, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: owner, Title: "title", Body: "body", Tags: []string{"go"}})
+	require.NoError(t, err)
+	id := created.Id
+	require.Len(t, listed(), 1)
+	_, err = ms.AddEditor(ctx, &types.MsgAddEditor{Creator: owner, Id: id, Editor: editor})
+	require.NoError(t, err)
+
+	_, err = ms.HidePost(ctx, &types.MsgHidePost{Creator: owner, Id: id, Reason: types.HideReason_HIDE_REASON_SPAM})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.UnhidePost(ctx, &types.MsgUnhidePost{Creator: moderator, Id: id})
+	require.ErrorIs(t, err, types.ErrPostNotHidden)
+	_, err = ms.HidePost(ctx, &types.MsgHidePost{Creator: moderator, Id: id + 1, Reason: types.HideReason_HIDE_REASON_SPAM})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = ms.HidePost(ctx, &types.MsgHidePost{Creator: moderator, Id: id, Reason: types.HideReason_HIDE_REASON_SPAM})
+	require.NoError(t, err)
+	_, err = ms.HidePost(ctx, &types.MsgHidePost{Creator: moderator, Id: id, Reason: types.HideReason_HIDE_REASON_OTHER})
+	require.ErrorIs(t, err, types.ErrPostHidden)
+
+	// hidden posts stay in state and show up with the reason, but are not listed
+	require.Empty(t, listed())
+	show, err := k.ShowPost(ctx, &types.QueryShowPostRequest{Id: id})
+	require.NoError(t, err)
+	require.True(t, show.Post.Hidden)
+	require.Equal(t, types.HideReason_HIDE_REASON_SPAM, show.Post.HideReason)
+	require.Equal(t, moderator, show.Post.HiddenBy)
+	byTag, err := k.ListPostsByTag(ctx, &types.QueryListPostsByTagRequest{Tag: "go"})
+	require.NoError(t, err)
+	require.Empty(t, byTag.Post)
+	byCreator, err := k.ListPostsByCreator(ctx, &types.QueryListPostsByCreatorRequest{Creator: owner})
+	require.NoError(t, err)
+	require.Empty(t, byCreator.Post)
+	byEditor, err := k.ListPostsByEditor(ctx, &types.QueryListPostsByEditorRequest{Editor: editor})
+	require.NoError(t, err)
+	require.Empty(t, byEditor.Post)
+
+	// updates by the owner keep the post hidden, and so is its history
+	_, err = ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: owner, Id: id, Title: "edited", Body: "body", Tags: []string{"go"}})
+	require.NoError(t, err)
+	post, _ := k.GetPost(sdk.UnwrapSDKContext(ctx), id)
+	require.True(t, post.Hidden)
+	require.Empty(t, listed())
+	_, err = k.ListPostRevisions(ctx, &types.QueryListPostRevisionsRequest{PostId: id})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = k.ShowPostRevision(ctx, &types.QueryShowPostRevisionRequest{PostId: id, Revision: 0})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = ms.UnhidePost(ctx, &types.MsgUnhidePost{Creator: owner, Id: id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.UnhidePost(ctx, &types.MsgUnhidePost{Creator: moderator, Id: id})
+	require.NoError(t, err)
+	post, _ = k.GetPost(sdk.UnwrapSDKContext(ctx), id)
+	require.False(t, post.Hidden)
+	require.Equal(t, types.HideReason_HIDE_REASON_UNSPECIFIED, post.HideReason)
+	require.Empty(t, post.HiddenBy)
+	require.Len(t, listed(), 1)
+	byTag, err = k.ListPostsByTag(ctx, &types.QueryListPostsByTagRequest{Tag: "go"})
+	require.NoError(t, err)
+	require.Len(t, byTag.Post, 1)
+	byCreator, err = k.ListPostsByCreator(ctx, &types.QueryListPostsByCreatorRequest{Creator: owner})
+	require.NoError(t, err)
+	require.Len(t, byCreator.Post, 1)
+	revisions, err := k.ListPostRevisions(ctx, &types.QueryListPostRevisionsRequest{PostId: id})
+	require.NoError(t, err)
+	require.Len(t, revisions.Revisions, 1)
+}
@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+// InitPostNFTClass creates the NFT class posts are minted under unless it
+// already exists.
+func (k Keeper) InitPostNFTClass(ctx sdk.Context) error {
+	if k.nftKeeper.HasClass(ctx, types.PostNFTClassID) {
+		return nil
+	}
+	return k.nftKeeper.SaveClass(ctx, nft.Class{
+		Id:          types.PostNFTClassID,
+		Name:        types.PostNFTClassName,
+		Symbol:      types.PostNFTClassSymbol,
+		Description: "Ownership of blog posts",
+	})
+}
+
+// MintPostNFT mints the NFT of a post to its owner.
+func (k Keeper) MintPostNFT(ctx sdk.Context, id uint64, owner string) error {
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	token := nft.NFT{
+		ClassId: types.PostNFTClassID,
+		Id:      types.PostNFTID(id),
+	}
+	if err := k.nftKeeper.Mint(ctx, token, ownerAddr); err != nil {
+		return errorsmod.Wrapf(err, "failed to mint nft of post %d", id)
+	}
+	return nil
+}
+
+// BurnPostNFT burns the NFT of a post if it exists.
+func (k Keeper) BurnPostNFT(ctx sdk.Context, id uint64) error {
+	if !k.HasPostNFT(ctx, id) {
+		return nil
+	}
+	if err := k.nftKeeper.Burn(ctx, types.PostNFTClassID, types.PostNFTID(id)); err != nil {
+		return errorsmod.Wrapf(err, "failed to burn nft of post %d", id)
+	}
+	return nil
+}
+
+// TransferPostOwnership hands a post and its NFT over to a new owner and
+// clears any pending transfer offer. A post that leaves governance is no
+// longer an announcement.
+func (k Keeper) TransferPostOwnership(ctx sdk.Context, post types.Post, newOwner string) error {
+	newOwnerAddr, err := sdk.AccAddressFromBech32(newOwner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address (%s)", err)
+	}
+	if k.HasPostNFT(ctx, post.Id) {
+		if err := k.nftKeeper.Transfer(ctx, types.PostNFTClassID, types.PostNFTID(post.Id), newOwnerAddr); err != nil {
+			return errorsmod.Wrapf(err, "failed to transfer nft of post %d", post.Id)
+		}
+	} else if err := k.MintPostNFT(ctx, post.Id, newOwner); err != nil {
+		return err
+	}
+	post.Creator = newOwner
+	post.PendingOwner = ""
+	post.Editors = slices.DeleteFunc(post.Editors, func(editor string) bool {
+		return editor == newOwner
+	})
+	post.Official = false
+	k.SetPost(ctx, post)
+	return nil
+}
+
+// HasPostNFT returns whether the NFT of a post exists.
+func (k Keeper) HasPostNFT(ctx sdk.Context, id uint64) bool {
+	return k.nftKeeper.HasNFT(ctx, types.PostNFTClassID, types.PostNFTID(id))
+}
+
+// PostOwner returns the account allowed to manage a post: the owner of its
+// NFT, or its creator for posts without one.
+func (k Keeper) PostOwner(ctx sdk.Context, post types.Post) string {
+	if !k.HasPostNFT(ctx, post.Id) {
+		return post.Creator
+	}
+	return k.nftKeeper.GetOwner(ctx, types.PostNFTClassID, types.PostNFTID(post.Id)).String()
+}
+
+// syncPostOwner returns the post with its creator set to the current owner of
+// its NFT. The NFT may change hands through x/nft without the blog module
+// noticing, in which case any transfer offer made by the former owner is
+// dropped as well and the post stops being an announcement. The caller is
+// responsible for saving the post.
+func (k Keeper) syncPostOwner(ctx sdk.Context, post types.Post) types.Post {
+	owner := k.PostOwner(ctx, post)
+	if owner == post.Creator {
+		return post
+	}
+	post.Creator = owner
+	post.PendingOwner = ""
+	post.Editors = slices.DeleteFunc(post.Editors, func(editor string) bool {
+		return editor == owner
+	})
+	post.Official = false
+	return post
+}
+
+// syncCreatorPosts brings the posts of an account in line with their NFTs
+// before they are acted on by creator. Posts the account is still indexed
+// under but whose NFT was sent away move to the new holder, and posts whose
+// NFT the account received through x/nft are indexed under it.
+func (k Keeper) syncCreatorPosts(ctx sdk.Context, creator string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostCreatorKeyPrefix(creator))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var ids []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	iterator.Close()
+
+	if creatorAddr, err := sdk.AccAddressFromBech32(creator); err == nil {
+		for _, token := range k.nftKeeper.GetNFTsOfClassByOwner(ctx, types.PostNFTClassID, creatorAddr) {
+			if id, ok := types.ParsePostNFTID(token.Id); ok {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	for _, id := range ids {
+		post, found := k.GetPost(ctx, id)
+		if !found {
+			continue
+		}
+		if synced := k.syncPostOwner(ctx, post); synced.Creator != post.Creator {
+			k.SetPost(ctx, synced)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "blog/testutil/keeper"
+	"blog/testutil/sample"
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+)
+
+func TestPostNFTOwnership(t *testing.T) {
+	k, _, nftKeeper, ctx := keepertest.BlogKeeperWithMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	creator, buyer := sample.AccAddress(), sample.AccAddress()
+
+	created, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: creator, Title: "title", Body: "body"})
+	require.NoError(t, err)
+	nftID := types.PostNFTID(created.Id)
+	require.True(t, nftKeeper.HasNFT(ctx, types.PostNFTClassID, nftID))
+	require.Equal(t, creator, nftKeeper.GetOwner(ctx, types.PostNFTClassID, nftID).String())
+
+	require.NoError(t, nftKeeper.Transfer(ctx, types.PostNFTClassID, nftID, sdk.MustAccAddressFromBech32(buyer)))
+
+	// the creator no longer manages the post once the NFT changes hands
+	_, err = ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: creator, Id: created.Id, Title: "mine", Body: "body"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.DeletePost(ctx, &types.MsgDeletePost{Creator: creator, Id: created.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: buyer, Id: created.Id, Title: "new title", Body: "body"})
+	require.NoError(t, err)
+	post, _ := k.GetPost(ctx, created.Id)
+	require.Equal(t, "new title", post.Title)
+	require.Equal(t, buyer, post.Creator)
+	require.Equal(t, creator, post.OriginalAuthor)
+
+	_, err = ms.DeletePost(ctx, &types.MsgDeletePost{Creator: buyer, Id: created.Id})
+	require.NoError(t, err)
+	require.False(t, nftKeeper.HasNFT(ctx, types.PostNFTClassID, nftID))
+	post, _ = k.GetPost(ctx, created.Id)
+	require.Equal(t, buyer, post.DeletedBy)
+
+	// only the account that deleted the post can restore it
+	_, err = ms.RestorePost(ctx, &types.MsgRestorePost{Creator: creator, Id: created.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.RestorePost(ctx, &types.MsgRestorePost{Creator: buyer, Id: created.Id})
+	require.NoError(t, err)
+	require.Equal(t, buyer, nftKeeper.GetOwner(ctx, types.PostNFTClassID, nftID).String())
+	post, _ = k.GetPost(ctx, created.Id)
+	require.Empty(t, post.DeletedBy)
+}
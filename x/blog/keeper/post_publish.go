@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// GetPostPublishQueueKey returns the publish queue key of a post, ordered by
+// publish time.
+func GetPostPublishQueueKey(publishAt time.Time, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(publishAt), GetPostIDBytes(id)...)
+}
+
+// IsScheduledPost reports whether a post is a draft waiting in the publish
+// queue. Deleted posts are never published.
+func IsScheduledPost(post types.Post) bool {
+	return post.Status == types.PostStatus_POST_STATUS_DRAFT && !post.PublishAt.IsZero() && !post.Deleted
+}
+
+// SetPostPublished publishes a post at the given time, taking it out of the
+// publish queue if it was scheduled.
+func (k Keeper) SetPostPublished(ctx sdk.Context, post types.Post, publishAt time.Time) {
+	if IsScheduledPost(post) {
+		k.RemoveFromPostPublishQueue(ctx, post.PublishAt, post.Id)
+	}
+	post.Status = types.PostStatus_POST_STATUS_PUBLISHED
+	post.PublishAt = publishAt
+	k.SetPost(ctx, post)
+}
+
+// SchedulePost schedules a draft to be published at the given time, replacing
+// any earlier schedule.
+func (k Keeper) SchedulePost(ctx sdk.Context, post types.Post, publishAt time.Time) {
+	if IsScheduledPost(post) {
+		k.RemoveFromPostPublishQueue(ctx, post.PublishAt, post.Id)
+	}
+	post.PublishAt = publishAt
+	k.SetPost(ctx, post)
+	k.InsertPostPublishQueue(ctx, publishAt, post.Id)
+}
+
+func (k Keeper) InsertPostPublishQueue(ctx sdk.Context, publishAt time.Time, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostPublishQueueKey))
+	store.Set(GetPostPublishQueueKey(publishAt, id), []byte{})
+}
+
+func (k Keeper) RemoveFromPostPublishQueue(ctx sdk.Context, publishAt time.Time, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostPublishQueueKey))
+	store.Delete(GetPostPublishQueueKey(publishAt, id))
+}
+
+// PublishScheduledPosts publishes every draft whose publish time is at or
+// before the current block time. Posts purged in the meantime are skipped,
+// and the drafts of blocked owners lose their schedule instead.
+func (k Keeper) PublishScheduledPosts(ctx sdk.Context) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostPublishQueueKey))
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+		post, found := k.GetPost(ctx, sdk.BigEndianToUint64(key[len(key)-8:]))
+		if !found || !IsScheduledPost(post) {
+			continue
+		}
+		if k.IsBlocked(ctx, k.PostOwner(ctx, post)) {
+			post.PublishAt = time.Time{}
+			k.SetPost(ctx, post)
+			continue
+		}
+		post.Status = types.PostStatus_POST_STATUS_PUBLISHED
+		k.SetPost(ctx, post)
+	}
+}
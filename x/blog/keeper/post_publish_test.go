@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func TestPublishPost(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(now)
+	creator := sample.AccAddress()
+
+	published, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "published", Tags: []string{"go"}})
+	require.NoError(t, err)
+	draft, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "draft", Tags: []string{"go"}, Status: types.PostStatus_POST_STATUS_DRAFT})
+	require.NoError(t, err)
+	unlisted, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "unlisted", Status: types.PostStatus_POST_STATUS_UNLISTED})
+	require.NoError(t, err)
+
+	post, _ := k.GetPost(sdkCtx, published.Id)
+	require.Equal(t, types.PostStatus_POST_STATUS_PUBLISHED, post.Status)
+	require.Equal(t, now, post.PublishAt)
+
+	// only published posts are listed by default
+	list, err := k.ListPost(sdkCtx, &types.QueryListPostRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Post, 1)
+	require.Equal(t, published.Id, list.Post[0].Id)
+	_, err = k.ListPost(sdkCtx, &types.QueryListPostRequest{Status: types.PostStatus_POST_STATUS_UNLISTED})
+	require.Error(t, err)
+	_, err = k.ShowPost(sdkCtx, &types.QueryShowPostRequest{Id: unlisted.Id})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), k.GetTagCount(sdkCtx, "go"))
+
+	_, err = ms.PublishPost(sdkCtx, &types.MsgPublishPost{Creator: sample.AccAddress(), Id: draft.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = ms.PublishPost(sdkCtx, &types.MsgPublishPost{Creator: creator, Id: published.Id})
+	require.ErrorIs(t, err, types.ErrPostPublished)
+	past := now.Add(-time.Hour)
+	_, err = ms.PublishPost(sdkCtx, &types.MsgPublishPost{Creator: creator, Id: draft.Id, PublishAt: &past})
+	require.ErrorIs(t, err, types.ErrInvalidPublishTime)
+	_, err = ms.PublishPost(sdkCtx, &types.MsgPublishPost{Creator: creator, Id: unlisted.Id, PublishAt: &now})
+	require.ErrorIs(t, err, types.ErrInvalidPublishTime)
+
+	_, err = ms.PublishPost(sdkCtx, &types.MsgPublishPost{Creator: creator, Id: unlisted.Id})
+	require.NoError(t, err)
+	_, err = ms.PublishPost(sdkCtx, &types.MsgPublishPost{Creator: creator, Id: draft.Id})
+	require.NoError(t, err)
+	post, _ = k.GetPost(sdkCtx, draft.Id)
+	require.Equal(t, types.PostStatus_POST_STATUS_PUBLISHED, post.Status)
+	require.Equal(t, now, post.PublishAt)
+	require.Equal(t, uint64(2), k.GetTagCount(sdkCtx, "go"))
+	list, err = k.ListPost(sdkCtx, &types.QueryListPostRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Post, 3)
+}
+
+func TestScheduledPublishing(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(now)
+	creator := sample.AccAddress()
+	draftStatus := types.PostStatus_POST_STATUS_DRAFT
+
+	_, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Status: draftStatus, PublishAt: &now})
+	require.ErrorIs(t, err, types.ErrInvalidPublishTime)
+
+	launch := now.Add(time.Hour)
+	first, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "first", Status: draftStatus, PublishAt: &launch})
+	require.NoError(t, err)
+	second, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "second", Status: draftStatus})
+	require.NoError(t, err)
+
+	// rescheduling replaces the earlier schedule
+	later := launch.Add(time.Hour)
+	_, err = ms.PublishPost(sdkCtx, &types.MsgPublishPost{Creator: creator, Id: second.Id, PublishAt: &launch})
+	require.NoError(t, err)
+	_, err = ms.PublishPost(sdkCtx, &types.MsgPublishPost{Creator: creator, Id: second.Id, PublishAt: &later})
+	require.NoError(t, err)
+
+	k.PublishScheduledPosts(sdkCtx.WithBlockTime(launch.Add(-time.Second)))
+	list, err := k.ListPost(sdkCtx, &types.QueryListPostRequest{})
+	require.NoError(t, err)
+	require.Empty(t, list.Post)
+
+	k.PublishScheduledPosts(sdkCtx.WithBlockTime(launch))
+	post, _ := k.GetPost(sdkCtx, first.Id)
+	require.Equal(t, types.PostStatus_POST_STATUS_PUBLISHED, post.Status)
+	require.Equal(t, launch, post.PublishAt)
+	post, _ = k.GetPost(sdkCtx, second.Id)
+	require.Equal(t, draftStatus, post.Status)
+
+	k.PublishScheduledPosts(sdkCtx.WithBlockTime(later))
+	post, _ = k.GetPost(sdkCtx, second.Id)
+	require.Equal(t, types.PostStatus_POST_STATUS_PUBLISHED, post.Status)
+	require.Equal(t, later, post.PublishAt)
+
+	// deleting a scheduled draft takes it out of the queue for good
+	third, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "third", Status: draftStatus, PublishAt: &later})
+	require.NoError(t, err)
+	_, err = ms.DeletePost(sdkCtx, &types.MsgDeletePost{Creator: creator, Id: third.Id})
+	require.NoError(t, err)
+	k.PublishScheduledPosts(sdkCtx.WithBlockTime(later))
+	post, _ = k.GetPost(sdkCtx, third.Id)
+	require.Equal(t, draftStatus, post.Status)
+	_, err = ms.RestorePost(sdkCtx, &types.MsgRestorePost{Creator: creator, Id: third.Id})
+	require.NoError(t, err)
+	post, _ = k.GetPost(sdkCtx, third.Id)
+	require.Equal(t, draftStatus, post.Status)
+	require.True(t, post.PublishAt.IsZero())
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// GetPostRevisionKeyPrefix returns the store prefix holding all revisions of a post.
+func GetPostRevisionKeyPrefix(postID uint64) []byte {
+	return append(types.KeyPrefix(types.PostRevisionKey), GetPostIDBytes(postID)...)
+}
+
+func GetPostRevisionBytes(revision uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, revision)
+	return bz
+}
+
+func (k Keeper) SetPostRevision(ctx sdk.Context, revision types.PostRevision) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostRevisionKeyPrefix(revision.PostId))
+	b := k.cdc.MustMarshal(&revision)
+	store.Set(GetPostRevisionBytes(revision.Revision), b)
+}
+
+func (k Keeper) GetPostRevision(ctx sdk.Context, postID uint64, revision uint64) (val types.PostRevision, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostRevisionKeyPrefix(postID))
+	b := store.Get(GetPostRevisionBytes(revision))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// PrunePostRevisions removes every revision of a post numbered lower than before.
+func (k Keeper) PrunePostRevisions(ctx sdk.Context, postID uint64, before uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostRevisionKeyPrefix(postID))
+	iterator := store.Iterator(nil, GetPostRevisionBytes(before))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RemovePostRevisions removes every revision of a post.
+func (k Keeper) RemovePostRevisions(ctx sdk.Context, postID uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostRevisionKeyPrefix(postID))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) GetAllPostRevision(ctx sdk.Context) (list []types.PostRevision) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostRevisionKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PostRevision
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func TestUpdatePostRevisions(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	creator := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.MaxRevisions = 2
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	res, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "v0", Body: "body"})
+	require.NoError(t, err)
+
+	for _, title := range []string{"v1", "v2", "v3"} {
+		_, err = ms.UpdatePost(sdkCtx, &types.MsgUpdatePost{Creator: creator, Id: res.Id, Title: title, Body: "body"})
+		require.NoError(t, err)
+	}
+
+	post, found := k.GetPost(sdkCtx, res.Id)
+	require.True(t, found)
+	require.Equal(t, "v3", post.Title)
+	require.Equal(t, uint64(3), post.Revision)
+
+	// only the two most recent previous versions are kept
+	_, found = k.GetPostRevision(sdkCtx, res.Id, 0)
+	require.False(t, found)
+
+	listRes, err := k.ListPostRevisions(sdkCtx, &types.QueryListPostRevisionsRequest{PostId: res.Id})
+	require.NoError(t, err)
+	require.Len(t, listRes.Revisions, 2)
+	require.Equal(t, "v1", listRes.Revisions[0].Title)
+	require.Equal(t, uint64(1), listRes.Revisions[0].Revision)
+	require.Equal(t, "v2", listRes.Revisions[1].Title)
+
+	showRes, err := k.ShowPostRevision(sdkCtx, &types.QueryShowPostRevisionRequest{PostId: res.Id, Revision: 2})
+	require.NoError(t, err)
+	require.Equal(t, "v2", showRes.Revision.Title)
+
+	// deleting a post keeps its history until the post is purged
+	_, err = ms.DeletePost(sdkCtx, &types.MsgDeletePost{Creator: creator, Id: res.Id})
+	require.NoError(t, err)
+	require.Len(t, k.GetAllPostRevision(sdkCtx), 2)
+}
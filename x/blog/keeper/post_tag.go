@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// GetPostTagKeyPrefix returns the store prefix indexing the posts carrying a tag.
+func GetPostTagKeyPrefix(tag string) []byte {
+	key := append(types.KeyPrefix(types.PostTagKey), []byte(tag)...)
+	return append(key, []byte("/")...)
+}
+
+// indexedPostTags returns the tags a post is indexed under. Only published
+// posts are indexed, and deleted or hidden ones are left out until they are
+// restored or unhidden.
+func indexedPostTags(post types.Post) []string {
+	if post.Deleted || post.Hidden || post.Status != types.PostStatus_POST_STATUS_PUBLISHED {
+		return nil
+	}
+	return post.Tags
+}
+
+// reindexPostTags moves a post from the old set of tags to the new one,
+// keeping the tag usage counts in step.
+func (k Keeper) reindexPostTags(ctx sdk.Context, id uint64, oldTags, newTags []string) {
+	keep := make(map[string]bool, len(newTags))
+	for _, tag := range newTags {
+		keep[tag] = true
+	}
+	indexed := make(map[string]bool, len(oldTags))
+	for _, tag := range oldTags {
+		indexed[tag] = true
+		if !keep[tag] {
+			k.removePostTagIndex(ctx, tag, id)
+		}
+	}
+	for _, tag := range newTags {
+		if !indexed[tag] {
+			k.setPostTagIndex(ctx, tag, id)
+		}
+	}
+}
+
+func (k Keeper) setPostTagIndex(ctx sdk.Context, tag string, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostTagKeyPrefix(tag))
+	if store.Has(GetPostIDBytes(id)) {
+		return
+	}
+	store.Set(GetPostIDBytes(id), []byte{})
+	k.SetTagCount(ctx, tag, k.GetTagCount(ctx, tag)+1)
+}
+
+func (k Keeper) removePostTagIndex(ctx sdk.Context, tag string, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostTagKeyPrefix(tag))
+	if !store.Has(GetPostIDBytes(id)) {
+		return
+	}
+	store.Delete(GetPostIDBytes(id))
+	k.SetTagCount(ctx, tag, k.GetTagCount(ctx, tag)-1)
+}
+
+// GetTagCount returns the number of live posts carrying a tag.
+func (k Keeper) GetTagCount(ctx sdk.Context, tag string) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TagCountKey))
+	bz := store.Get([]byte(tag))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetTagCount sets the usage count of a tag. Tags no longer in use are
+// removed from the store.
+func (k Keeper) SetTagCount(ctx sdk.Context, tag string, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TagCountKey))
+	if count == 0 {
+		store.Delete([]byte(tag))
+		return
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set([]byte(tag), bz)
+}
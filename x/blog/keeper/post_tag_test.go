@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func TestPostTags(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	creator := sample.AccAddress()
+
+	res, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "a", Tags: []string{"Go", "cosmos", "go"}})
+	require.NoError(t, err)
+	post, _ := k.GetPost(sdkCtx, res.Id)
+	require.Equal(t, []string{"go", "cosmos"}, post.Tags)
+	_, err = ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "b", Tags: []string{"go"}})
+	require.NoError(t, err)
+
+	byTag, err := k.ListPostsByTag(sdkCtx, &types.QueryListPostsByTagRequest{Tag: "GO"})
+	require.NoError(t, err)
+	require.Len(t, byTag.Post, 2)
+	tags, err := k.ListTags(sdkCtx, &types.QueryListTagsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.TagCount{{Tag: "cosmos", Count: 1}, {Tag: "go", Count: 2}}, tags.Tags)
+
+	// updating a post replaces its tags in the index
+	_, err = ms.UpdatePost(sdkCtx, &types.MsgUpdatePost{Creator: creator, Id: res.Id, Title: "a", Tags: []string{"cosmos", "ibc"}})
+	require.NoError(t, err)
+	byTag, err = k.ListPostsByTag(sdkCtx, &types.QueryListPostsByTagRequest{Tag: "go"})
+	require.NoError(t, err)
+	require.Len(t, byTag.Post, 1)
+	tags, err = k.ListTags(sdkCtx, &types.QueryListTagsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.TagCount{{Tag: "cosmos", Count: 1}, {Tag: "go", Count: 1}, {Tag: "ibc", Count: 1}}, tags.Tags)
+
+	// deleted posts drop out of the index until restored
+	_, err = ms.DeletePost(sdkCtx, &types.MsgDeletePost{Creator: creator, Id: res.Id})
+	require.NoError(t, err)
+	tags, err = k.ListTags(sdkCtx, &types.QueryListTagsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.TagCount{{Tag: "go", Count: 1}}, tags.Tags)
+	_, err = ms.RestorePost(sdkCtx, &types.MsgRestorePost{Creator: creator, Id: res.Id})
+	require.NoError(t, err)
+	byTag, err = k.ListPostsByTag(sdkCtx, &types.QueryListPostsByTagRequest{Tag: "ibc"})
+	require.NoError(t, err)
+	require.Len(t, byTag.Post, 1)
+
+	k.RemovePost(sdkCtx, res.Id)
+	tags, err = k.ListTags(sdkCtx, &types.QueryListTagsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.TagCount{{Tag: "go", Count: 1}}, tags.Tags)
+}
+
+func TestPostTagLimits(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	creator := sample.AccAddress()
+
+	params := types.DefaultParams()
+	params.MaxTags = 2
+	params.MaxTagLength = 5
+	require.NoError(t, k.SetParams(sdkCtx, params))
+
+	_, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Tags: []string{"a", "b", "c"}})
+	require.ErrorIs(t, err, types.ErrTooManyTags)
+	_, err = ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Tags: []string{"abcdef"}})
+	require.ErrorIs(t, err, types.ErrTagTooLong)
+	res, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Tags: []string{"a", "b", "A"}})
+	require.NoError(t, err)
+
+	_, err = ms.UpdatePost(sdkCtx, &types.MsgUpdatePost{Creator: creator, Id: res.Id, Tags: []string{"a", "b", "c"}})
+	require.ErrorIs(t, err, types.ErrTooManyTags)
+	_, err = ms.UpdatePost(sdkCtx, &types.MsgUpdatePost{Creator: creator, Id: res.Id, Tags: []string{"a b"}})
+	require.ErrorIs(t, err, types.ErrInvalidTag)
+}
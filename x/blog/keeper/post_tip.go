@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"blog/x/blog/types"
+)
+
+// GetTipRankKeyPrefix returns the store prefix ordering posts by the tips
+// they received in a denom. The denom is length-prefixed because denoms may
+// contain slashes, so that no denom prefix covers the ranking of another.
+func GetTipRankKeyPrefix(denom string) []byte {
+	key := append(types.KeyPrefix(types.TipRankKey), byte(len(denom)))
+	return append(key, []byte(denom)...)
+}
+
+// GetTipRankKey returns the rank key of a post under the denom prefix. The
+// amount is length-prefixed so that keys sort in numeric order.
+func GetTipRankKey(amount math.Int, id uint64) []byte {
+	bz := amount.BigInt().Bytes()
+	key := append([]byte{byte(len(bz))}, bz...)
+	return append(key, GetPostIDBytes(id)...)
+}
+
+// RecordPostTip adds a tip to the totals of a post and of its owner and
+// emits a tip event. The funds must already have been sent to the owner.
+func (k Keeper) RecordPostTip(ctx sdk.Context, post types.Post, sender string, amount sdk.Coins) {
+	post = k.syncPostOwner(ctx, post)
+	post.Tips = post.Tips.Add(amount...)
+	k.SetPost(ctx, post)
+	k.SetAuthorTips(ctx, types.AuthorTips{
+		Author: post.Creator,
+		Tips:   k.GetAuthorTips(ctx, post.Creator).Add(amount...),
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTipPost,
+			sdk.NewAttribute(types.AttributeKeyPostID, strconv.FormatUint(post.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, post.Creator),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
+
+// ForwardPostTip pays a tip held by the module account to the owner of the
+// post and records it. It is used for tips arriving through IBC transfers,
+// whose vouchers are first received by the module account.
+func (k Keeper) ForwardPostTip(ctx sdk.Context, post types.Post, sender string, amount sdk.Coins) error {
+	post = k.syncPostOwner(ctx, post)
+	if post.Official {
+		return errorsmod.Wrapf(types.ErrOfficialPost, "post %d cannot be tipped", post.Id)
+	}
+	recipient, err := sdk.AccAddressFromBech32(post.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid post creator address (%s)", err)
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return errorsmod.Wrap(err, "failed to forward tip")
+	}
+	k.RecordPostTip(ctx, post, sender, amount)
+	return nil
+}
+
+// reindexPostTips moves a post in the tip ranking of every denom whose total
+// changed.
+func (k Keeper) reindexPostTips(ctx sdk.Context, id uint64, oldTips, newTips sdk.Coins) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	for _, coin := range oldTips {
+		if !newTips.AmountOf(coin.Denom).Equal(coin.Amount) {
+			store := prefix.NewStore(storeAdapter, GetTipRankKeyPrefix(coin.Denom))
+			store.Delete(GetTipRankKey(coin.Amount, id))
+		}
+	}
+	for _, coin := range newTips {
+		if !oldTips.AmountOf(coin.Denom).Equal(coin.Amount) {
+			store := prefix.NewStore(storeAdapter, GetTipRankKeyPrefix(coin.Denom))
+			store.Set(GetTipRankKey(coin.Amount, id), []byte{})
+		}
+	}
+}
+
+// SetAuthorTips sets the cumulative tips received by an author.
+func (k Keeper) SetAuthorTips(ctx sdk.Context, authorTips types.AuthorTips) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuthorTipsKey))
+	b := k.cdc.MustMarshal(&authorTips)
+	store.Set([]byte(authorTips.Author), b)
+}
+
+// GetAuthorTips returns the cumulative tips received by an author.
+func (k Keeper) GetAuthorTips(ctx sdk.Context, author string) sdk.Coins {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuthorTipsKey))
+	b := store.Get([]byte(author))
+	if b == nil {
+		return sdk.Coins{}
+	}
+	var val types.AuthorTips
+	k.cdc.MustUnmarshal(b, &val)
+	return val.Tips
+}
+
+func (k Keeper) GetAllAuthorTips(ctx sdk.Context) (list []types.AuthorTips) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.AuthorTipsKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuthorTips
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	keepertest "blog/testutil/keeper"
+	"blog/testutil/sample"
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+)
+
+func TestTipPost(t *testing.T) {
+	k, bank, ctx := keepertest.BlogKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	author, fan := sample.AccAddress(), sample.AccAddress()
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+	bank.Fund(sdk.MustAccAddressFromBech32(fan), stake(100))
+
+	first, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: author, Title: "first"})
+	require.NoError(t, err)
+	second, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: author, Title: "second"})
+	require.NoError(t, err)
+	third, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: fan, Title: "third"})
+	require.NoError(t, err)
+
+	_, err = ms.TipPost(ctx, &types.MsgTipPost{Creator: author, PostId: first.Id, Amount: stake(1)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = ms.TipPost(ctx, &types.MsgTipPost{Creator: fan, PostId: first.Id, Amount: stake(1000)})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+
+	_, err = ms.TipPost(ctx, &types.MsgTipPost{Creator: fan, PostId: first.Id, Amount: stake(10)})
+	require.NoError(t, err)
+	_, err = ms.TipPost(ctx, &types.MsgTipPost{Creator: fan, PostId: second.Id, Amount: stake(30)})
+	require.NoError(t, err)
+	_, err = ms.TipPost(ctx, &types.MsgTipPost{Creator: fan, PostId: first.Id, Amount: stake(5)})
+	require.NoError(t, err)
+	require.Equal(t, stake(45), bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(author)))
+
+	events := ctx.EventManager().Events()
+	last := events[len(events)-1]
+	require.Equal(t, types.EventTypeTipPost, last.Type)
+
+	tips, err := k.ShowPostTips(ctx, &types.QueryShowPostTipsRequest{PostId: first.Id})
+	require.NoError(t, err)
+	require.Equal(t, stake(15), tips.Tips)
+	require.Equal(t, author, tips.Author)
+	require.Equal(t, stake(45), tips.AuthorTips)
+
+	top, err := k.TopTippedPosts(ctx, &types.QueryTopTippedPostsRequest{Denom: "stake"})
+	require.NoError(t, err)
+	require.Len(t, top.Post, 2)
+	require.Equal(t, second.Id, top.Post[0].Id)
+	require.Equal(t, first.Id, top.Post[1].Id)
+
+	top, err = k.TopTippedPosts(ctx, &types.QueryTopTippedPostsRequest{Denom: "stake", Pagination: &query.PageRequest{Limit: 1}})
+	require.NoError(t, err)
+	require.Len(t, top.Post, 1)
+	require.Equal(t, second.Id, top.Post[0].Id)
+
+	// posts that were never tipped or were deleted are not ranked
+	_, err = ms.DeletePost(ctx, &types.MsgDeletePost{Creator: author, Id: second.Id})
+	require.NoError(t, err)
+	top, err = k.TopTippedPosts(ctx, &types.QueryTopTippedPostsRequest{Denom: "stake"})
+	require.NoError(t, err)
+	require.Len(t, top.Post, 1)
+	require.Equal(t, first.Id, top.Post[0].Id)
+	require.NotEqual(t, third.Id, top.Post[0].Id)
+
+	_, err = k.TopTippedPosts(ctx, &types.QueryTopTippedPostsRequest{Denom: "!"})
+	require.Error(t, err)
+}
+
+func TestTipPostAfterNFTSend(t *testing.T) {
+	k, bank, nftKeeper, ctx := keepertest.BlogKeeperWithMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	author, buyer, fan := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+	bank.Fund(sdk.MustAccAddressFromBech32(fan), stake(100))
+
+	created, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: author, Title: "title"})
+	require.NoError(t, err)
+	_, err = ms.TransferPost(ctx, &types.MsgTransferPost{Creator: author, Id: created.Id, NewOwner: fan, Offer: true})
+	require.NoError(t, err)
+
+	// the NFT is sent through x/nft, behind the blog module's back
+	err = nftKeeper.Transfer(ctx, types.PostNFTClassID, types.PostNFTID(created.Id), sdk.MustAccAddressFromBech32(buyer))
+	require.NoError(t, err)
+
+	tips, err := k.ShowPostTips(ctx, &types.QueryShowPostTipsRequest{PostId: created.Id})
+	require.NoError(t, err)
+	require.Equal(t, buyer, tips.Author)
+
+	_, err = ms.TipPost(ctx, &types.MsgTipPost{Creator: fan, PostId: created.Id, Amount: stake(10)})
+	require.NoError(t, err)
+	require.Equal(t, stake(10), bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(buyer)))
+	require.True(t, bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(author)).IsZero())
+	require.Equal(t, stake(10), k.GetAuthorTips(ctx, buyer))
+	require.True(t, k.GetAuthorTips(ctx, author).IsZero())
+
+	// the tip brought the post record in line with its NFT
+	post, _ := k.GetPost(ctx, created.Id)
+	require.Equal(t, buyer, post.Creator)
+	require.Empty(t, post.PendingOwner)
+	res, err := k.ListPostsByCreator(ctx, &types.QueryListPostsByCreatorRequest{Creator: buyer})
+	require.NoError(t, err)
+	require.Len(t, res.Post, 1)
+	res, err = k.ListPostsByCreator(ctx, &types.QueryListPostsByCreatorRequest{Creator: author})
+	require.NoError(t, err)
+	require.Empty(t, res.Post)
+
+	// the new owner cannot tip their own post
+	_, err = ms.TipPost(ctx, &types.MsgTipPost{Creator: buyer, PostId: created.Id, Amount: stake(1)})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestTopTippedPostsNestedDenoms(t *testing.T) {
+	k, bank, ctx := keepertest.BlogKeeperWithBank(t)
+	ms := keeper.NewMsgServerImpl(k)
+	author, fan := sample.AccAddress(), sample.AccAddress()
+	parent, child := "factory/addr", "factory/addr/sub"
+	bank.Fund(sdk.MustAccAddressFromBech32(fan), sdk.NewCoins(sdk.NewInt64Coin(parent, 10), sdk.NewInt64Coin(child, 10)))
+
+	first, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: author, Title: "first"})
+	require.NoError(t, err)
+	second, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: author, Title: "second"})
+	require.NoError(t, err)
+	_, err = ms.TipPost(ctx, &types.MsgTipPost{Creator: fan, PostId: first.Id, Amount: sdk.NewCoins(sdk.NewInt64Coin(parent, 1))})
+	require.NoError(t, err)
+	_, err = ms.TipPost(ctx, &types.MsgTipPost{Creator: fan, PostId: second.Id, Amount: sdk.NewCoins(sdk.NewInt64Coin(child, 5))})
+	require.NoError(t, err)
+
+	// a denom extending another one with a slash has a ranking of its own
+	top, err := k.TopTippedPosts(ctx, &types.QueryTopTippedPostsRequest{Denom: parent})
+	require.NoError(t, err)
+	require.Len(t, top.Post, 1)
+	require.Equal(t, first.Id, top.Post[0].Id)
+	top, err = k.TopTippedPosts(ctx, &types.QueryTopTippedPostsRequest{Denom: child})
+	require.NoError(t, err)
+	require.Len(t, top.Post, 1)
+	require.Equal(t, second.Id, top.Post[0].Id)
+}
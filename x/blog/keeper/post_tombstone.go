@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// GetPostPurgeQueueKey returns the purge queue key of a post, ordered by purge time.
+func GetPostPurgeQueueKey(purgeAt time.Time, id uint64) []byte {
+	return append(sdk.FormatTimeBytes(purgeAt), GetPostIDBytes(id)...)
+}
+
+// TombstonePost marks a post as deleted and schedules it to be purged once
+// the delete grace period has passed. A scheduled draft loses its schedule,
+// so it comes back as a plain draft if it is restored.
+func (k Keeper) TombstonePost(ctx sdk.Context, post types.Post) {
+	if IsScheduledPost(post) {
+		k.RemoveFromPostPublishQueue(ctx, post.PublishAt, post.Id)
+		post.PublishAt = time.Time{}
+	}
+	post.Deleted = true
+	post.DeletedAt = ctx.BlockTime()
+	post.PurgeAt = ctx.BlockTime().Add(k.GetParams(ctx).DeleteGracePeriod)
+	k.SetPost(ctx, post)
+	k.InsertPostPurgeQueue(ctx, post.PurgeAt, post.Id)
+}
+
+// ClearPostTombstone clears the tombstone of a deleted post and removes it from the
+// purge queue.
+func (k Keeper) ClearPostTombstone(ctx sdk.Context, post types.Post) {
+	k.RemoveFromPostPurgeQueue(ctx, post.PurgeAt, post.Id)
+	post.Deleted = false
+	post.DeletedAt = time.Time{}
+	post.PurgeAt = time.Time{}
+	post.DeletedBy = ""
+	k.SetPost(ctx, post)
+}
+
+func (k Keeper) InsertPostPurgeQueue(ctx sdk.Context, purgeAt time.Time, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostPurgeQueueKey))
+	store.Set(GetPostPurgeQueueKey(purgeAt, id), []byte{})
+}
+
+func (k Keeper) RemoveFromPostPurgeQueue(ctx sdk.Context, purgeAt time.Time, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostPurgeQueueKey))
+	store.Delete(GetPostPurgeQueueKey(purgeAt, id))
+}
+
+// PurgeExpiredPosts permanently removes every deleted post whose grace period
+// ended at or before the current block time.
+func (k Keeper) PurgeExpiredPosts(ctx sdk.Context) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostPurgeQueueKey))
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		id := sdk.BigEndianToUint64(key[len(key)-8:])
+		k.RemovePost(ctx, id)
+		k.RemovePostRevisions(ctx, id)
+		k.RemovePostComments(ctx, id)
+		k.RemovePostReactions(ctx, id)
+		k.RemovePostReports(ctx, id)
+		k.RemoveFromPostReviewQueue(ctx, id)
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func TestDeleteAndRestorePost(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	creator := sample.AccAddress()
+	deletedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(deletedAt)
+
+	res, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "title", Body: "body"})
+	require.NoError(t, err)
+
+	_, err = ms.DeletePost(sdkCtx, &types.MsgDeletePost{Creator: sample.AccAddress(), Id: res.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = ms.DeletePost(sdkCtx, &types.MsgDeletePost{Creator: creator, Id: res.Id})
+	require.NoError(t, err)
+
+	post, found := k.GetPost(sdkCtx, res.Id)
+	require.True(t, found)
+	require.True(t, post.Deleted)
+	require.Equal(t, deletedAt, post.DeletedAt)
+	require.Equal(t, deletedAt.Add(types.DefaultDeleteGracePeriod), post.PurgeAt)
+
+	// deleted posts are hidden unless explicitly requested
+	_, err = k.ShowPost(sdkCtx, &types.QueryShowPostRequest{Id: res.Id})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = k.ShowPost(sdkCtx, &types.QueryShowPostRequest{Id: res.Id, IncludeDeleted: true})
+	require.NoError(t, err)
+	listRes, err := k.ListPost(sdkCtx, &types.QueryListPostRequest{})
+	require.NoError(t, err)
+	require.Empty(t, listRes.Post)
+	listRes, err = k.ListPost(sdkCtx, &types.QueryListPostRequest{IncludeDeleted: true})
+	require.NoError(t, err)
+	require.Len(t, listRes.Post, 1)
+
+	_, err = ms.UpdatePost(sdkCtx, &types.MsgUpdatePost{Creator: creator, Id: res.Id, Title: "new"})
+	require.ErrorIs(t, err, types.ErrPostDeleted)
+	_, err = ms.DeletePost(sdkCtx, &types.MsgDeletePost{Creator: creator, Id: res.Id})
+	require.ErrorIs(t, err, types.ErrPostDeleted)
+
+	_, err = ms.RestorePost(sdkCtx, &types.MsgRestorePost{Creator: creator, Id: res.Id})
+	require.NoError(t, err)
+	_, err = ms.RestorePost(sdkCtx, &types.MsgRestorePost{Creator: creator, Id: res.Id})
+	require.ErrorIs(t, err, types.ErrPostNotDeleted)
+
+	// a restored post is no longer purged
+	k.PurgeExpiredPosts(sdkCtx.WithBlockTime(deletedAt.Add(types.DefaultDeleteGracePeriod)))
+	post, found = k.GetPost(sdkCtx, res.Id)
+	require.True(t, found)
+	require.False(t, post.Deleted)
+}
+
+func TestPurgeExpiredPosts(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	creator := sample.AccAddress()
+	deletedAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(deletedAt)
+
+	res, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "title", Body: "body"})
+	require.NoError(t, err)
+	_, err = ms.UpdatePost(sdkCtx, &types.MsgUpdatePost{Creator: creator, Id: res.Id, Title: "new", Body: "body"})
+	require.NoError(t, err)
+	_, err = ms.DeletePost(sdkCtx, &types.MsgDeletePost{Creator: creator, Id: res.Id})
+	require.NoError(t, err)
+
+	// still restorable right before the grace period ends
+	k.PurgeExpiredPosts(sdkCtx.WithBlockTime(deletedAt.Add(types.DefaultDeleteGracePeriod - time.Second)))
+	_, found := k.GetPost(sdkCtx, res.Id)
+	require.True(t, found)
+
+	purgeCtx := sdkCtx.WithBlockTime(deletedAt.Add(types.DefaultDeleteGracePeriod))
+	_, err = ms.RestorePost(purgeCtx, &types.MsgRestorePost{Creator: creator, Id: res.Id})
+	require.ErrorIs(t, err, types.ErrRestoreWindowExpired)
+
+	k.PurgeExpiredPosts(purgeCtx)
+	_, found = k.GetPost(sdkCtx, res.Id)
+	require.False(t, found)
+	require.Empty(t, k.GetAllPostRevision(sdkCtx))
+}
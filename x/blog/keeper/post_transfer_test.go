@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	keepertest "blog/testutil/keeper"
+	"blog/testutil/sample"
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+)
+
+func TestTransferPost(t *testing.T) {
+	k, _, nftKeeper, ctx := keepertest.BlogKeeperWithMocks(t)
+	ms := keeper.NewMsgServerImpl(k)
+	alice, bob, carol := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	postsBy := func(creator string) []types.Post {
+		res, err := k.ListPostsByCreator(ctx, &types.QueryListPostsByCreatorRequest{Creator: creator})
+		require.NoError(t, err)
+		return res.Post
+	}
+
+	created, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: alice, Title: "title", Body: "body"})
+	require.NoError(t, err)
+	id := created.Id
+
+	_, err = ms.TransferPost(ctx, &types.MsgTransferPost{Creator: bob, Id: id, NewOwner: carol})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// a direct transfer hands the post over right away
+	_, err = ms.TransferPost(ctx, &types.MsgTransferPost{Creator: alice, Id: id, NewOwner: bob})
+	require.NoError(t, err)
+	post, _ := k.GetPost(ctx, id)
+	require.Equal(t, bob, post.Creator)
+	require.Equal(t, alice, post.OriginalAuthor)
+	require.Equal(t, bob, nftKeeper.GetOwner(ctx, types.PostNFTClassID, types.PostNFTID(id)).String())
+	require.Empty(t, postsBy(alice))
+	require.Len(t, postsBy(bob), 1)
+
+	_, err = ms.UpdatePost(ctx, &types.MsgUpdatePost{Creator: alice, Id: id, Title: "title", Body: "body"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// an offer has to be accepted by the account it was made to
+	_, err = ms.TransferPost(ctx, &types.MsgTransferPost{Creator: bob, Id: id, NewOwner: carol, Offer: true})
+	require.NoError(t, err)
+	post, _ = k.GetPost(ctx, id)
+	require.Equal(t, bob, post.Creator)
+	require.Equal(t, carol, post.PendingOwner)
+
+	_, err = ms.AcceptPostTransfer(ctx, &types.MsgAcceptPostTransfer{Creator: alice, Id: id})
+	require.ErrorIs(t, err, types.ErrNoTransferOffer)
+	_, err = ms.AcceptPostTransfer(ctx, &types.MsgAcceptPostTransfer{Creator: carol, Id: id})
+	require.NoError(t, err)
+	post, _ = k.GetPost(ctx, id)
+	require.Equal(t, carol, post.Creator)
+	require.Empty(t, post.PendingOwner)
+	require.Equal(t, alice, post.OriginalAuthor)
+	require.Empty(t, postsBy(bob))
+	require.Len(t, postsBy(carol), 1)
+
+	_, err = ms.AcceptPostTransfer(ctx, &types.MsgAcceptPostTransfer{Creator: carol, Id: id})
+	require.ErrorIs(t, err, types.ErrNoTransferOffer)
+
+	// an offer is void once the post NFT moves outside the blog module
+	_, err = ms.TransferPost(ctx, &types.MsgTransferPost{Creator: carol, Id: id, NewOwner: alice, Offer: true})
+	require.NoError(t, err)
+	require.NoError(t, nftKeeper.Transfer(ctx, types.PostNFTClassID, types.PostNFTID(id), sdk.MustAccAddressFromBech32(bob)))
+	_, err = ms.AcceptPostTransfer(ctx, &types.MsgAcceptPostTransfer{Creator: alice, Id: id})
+	require.ErrorIs(t, err, types.ErrNoTransferOffer)
+
+	// deleting the post withdraws the offer
+	_, err = ms.DeletePost(ctx, &types.MsgDeletePost{Creator: bob, Id: id})
+	require.NoError(t, err)
+	post, _ = k.GetPost(ctx, id)
+	require.Empty(t, post.PendingOwner)
+	_, err = ms.TransferPost(ctx, &types.MsgTransferPost{Creator: bob, Id: id, NewOwner: alice})
+	require.Error(t, err)
+}
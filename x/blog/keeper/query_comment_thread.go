@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+const (
+	// defaultCommentThreadLimit is the number of replies returned per level
+	// when the request does not set a pagination limit.
+	defaultCommentThreadLimit = 10
+	// maxCommentThreadLimit is the most replies returned per level.
+	maxCommentThreadLimit = 50
+	// maxCommentThreadNodes is the most comments returned by one query,
+	// counting the root of the thread.
+	maxCommentThreadNodes = 250
+)
+
+func (k Keeper) CommentThread(goCtx context.Context, req *types.QueryCommentThreadRequest) (*types.QueryCommentThreadResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	post, found := k.GetPost(ctx, req.PostId)
+	if !found || post.Deleted {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	root, found := k.GetComment(ctx, req.PostId, req.CommentId)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	maxDepth := req.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = k.GetParams(ctx).MaxCommentDepth
+	}
+	pageReq := &query.PageRequest{Limit: defaultCommentThreadLimit}
+	if req.Pagination != nil {
+		pageReq = &query.PageRequest{
+			Key:        req.Pagination.Key,
+			Offset:     req.Pagination.Offset,
+			Limit:      req.Pagination.Limit,
+			CountTotal: req.Pagination.CountTotal,
+			Reverse:    req.Pagination.Reverse,
+		}
+		if pageReq.Limit == 0 {
+			pageReq.Limit = defaultCommentThreadLimit
+		}
+	}
+	pageReq.Limit = min(pageReq.Limit, maxCommentThreadLimit)
+
+	remaining := uint64(maxCommentThreadNodes - 1)
+	thread, pageRes, err := k.commentThreadNode(ctx, root, pageReq, maxDepth, &remaining)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCommentThreadResponse{Thread: thread, Pagination: pageRes}, nil
+}
+
+// commentThreadNode builds the thread below comment, returning one page of
+// replies at every level down to depth levels. remaining is the number of
+// comments the query may still return; the replies of a level are counted
+// against it before their own replies are fetched, and a page is cut short
+// once it runs out.
+func (k Keeper) commentThreadNode(ctx sdk.Context, comment types.Comment, pageReq *query.PageRequest, depth uint64, remaining *uint64) (types.CommentThreadNode, *query.PageResponse, error) {
+	node := types.CommentThreadNode{Comment: comment}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetCommentReplyKeyPrefix(comment.PostId, comment.Id))
+
+	if depth == 0 || *remaining == 0 {
+		// point the caller at the first reply so the subtree can be fetched
+		// with a follow-up query
+		iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+		if iterator.Valid() {
+			node.RepliesNextKey = iterator.Key()
+		}
+		iterator.Close()
+		return node, &query.PageResponse{NextKey: node.RepliesNextKey}, nil
+	}
+
+	limit := pageReq.Limit
+	pageReq.Limit = min(limit, *remaining)
+	*remaining -= pageReq.Limit
+
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		reply, found := k.GetComment(ctx, comment.PostId, sdk.BigEndianToUint64(key))
+		if !found {
+			return nil
+		}
+
+		replyNode, _, err := k.commentThreadNode(ctx, reply, &query.PageRequest{Limit: limit}, depth-1, remaining)
+		if err != nil {
+			return err
+		}
+
+		node.Replies = append(node.Replies, replyNode)
+		return nil
+	})
+	if err != nil {
+		return node, nil, err
+	}
+	// give back what a short page did not use
+	*remaining += pageReq.Limit - uint64(len(node.Replies))
+
+	node.RepliesNextKey = pageRes.NextKey
+	return node, pageRes, nil
+}
@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) ListCommentsByPost(goCtx context.Context, req *types.QueryListCommentsByPostRequest) (*types.QueryListCommentsByPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	post, found := k.GetPost(ctx, req.PostId)
+	if !found || post.Deleted {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetCommentKeyPrefix(req.PostId))
+
+	var comments []types.Comment
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var comment types.Comment
+		if err := k.cdc.Unmarshal(value, &comment); err != nil {
+			return false, err
+		}
+
+		if comment.Depth > 0 && req.TopLevelOnly {
+			return false, nil
+		}
+
+		if accumulate {
+			comments = append(comments, comment)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListCommentsByPostResponse{Comments: comments, Pagination: pageRes}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) ListFeaturedPosts(goCtx context.Context, req *types.QueryListFeaturedPostsRequest) (*types.QueryListFeaturedPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.FeaturedPostKey))
+
+	var (
+		posts    []types.Post
+		featured []types.FeaturedPost
+	)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var elem types.FeaturedPost
+		if err := k.cdc.Unmarshal(value, &elem); err != nil {
+			return false, err
+		}
+
+		// entries expiring this block are only removed at its end
+		if elem.ExpiresAt != nil && !elem.ExpiresAt.After(ctx.BlockTime()) {
+			return false, nil
+		}
+		post, found := k.GetPost(ctx, elem.PostId)
+		if !found || post.Deleted || post.Hidden || post.Status != types.PostStatus_POST_STATUS_PUBLISHED {
+			return false, nil
+		}
+
+		if accumulate {
+			posts = append(posts, post)
+			featured = append(featured, elem)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListFeaturedPostsResponse{Post: posts, FeaturedPosts: featured, Pagination: pageRes}, nil
+}
@@ -17,18 +17,40 @@ func (k Keeper) ListPost(ctx context.Context, req *types.QueryListPostRequest) (
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
+	// unlisted posts are reachable by ID only
+	if req.Status == types.PostStatus_POST_STATUS_UNLISTED {
+		return nil, status.Error(codes.InvalidArgument, "unlisted posts cannot be listed")
+	}
+
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
 
+	want := req.Status
+	if want == types.PostStatus_POST_STATUS_UNSPECIFIED {
+		want = types.PostStatus_POST_STATUS_PUBLISHED
+	}
+
 	var posts []types.Post
-	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var post types.Post
 		if err := k.cdc.Unmarshal(value, &post); err != nil {
-			return err
+			return false, err
 		}
 
-		posts = append(posts, post)
-		return nil
+		if post.Deleted && !req.IncludeDeleted {
+			return false, nil
+		}
+		if post.Hidden {
+			return false, nil
+		}
+		if post.Status != want {
+			return false, nil
+		}
+
+		if accumulate {
+			posts = append(posts, post)
+		}
+		return true, nil
 	})
 
 	if err != nil {
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) ListPostRevisions(goCtx context.Context, req *types.QueryListPostRevisionsRequest) (*types.QueryListPostRevisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// the history of a hidden post is as hidden as the post itself
+	if post, found := k.GetPost(ctx, req.PostId); found && post.Hidden {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostRevisionKeyPrefix(req.PostId))
+
+	var revisions []types.PostRevision
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var revision types.PostRevision
+		if err := k.cdc.Unmarshal(value, &revision); err != nil {
+			return err
+		}
+
+		revisions = append(revisions, revision)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListPostRevisionsResponse{Revisions: revisions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) ListPostsByEditor(goCtx context.Context, req *types.QueryListPostsByEditorRequest) (*types.QueryListPostsByEditorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Editor); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid editor address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostEditorKeyPrefix(req.Editor))
+
+	var posts []types.Post
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		post, found := k.GetPost(ctx, sdk.BigEndianToUint64(key))
+		if !found || post.Hidden || (post.Deleted && !req.IncludeDeleted) ||
+			post.Status != types.PostStatus_POST_STATUS_PUBLISHED {
+			return false, nil
+		}
+
+		if accumulate {
+			posts = append(posts, post)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListPostsByEditorResponse{Post: posts, Pagination: pageRes}, nil
+}
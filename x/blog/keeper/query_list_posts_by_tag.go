@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) ListPostsByTag(goCtx context.Context, req *types.QueryListPostsByTagRequest) (*types.QueryListPostsByTagResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	tag, err := types.NormalizeTag(req.Tag)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetPostTagKeyPrefix(tag))
+
+	var posts []types.Post
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		post, found := k.GetPost(ctx, sdk.BigEndianToUint64(key))
+		if !found {
+			return status.Errorf(codes.Internal, "indexed post %d not found", sdk.BigEndianToUint64(key))
+		}
+		posts = append(posts, post)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListPostsByTagResponse{Post: posts, Pagination: pageRes}, nil
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) ListReactionsByAccount(goCtx context.Context, req *types.QueryListReactionsByAccountRequest) (*types.QueryListReactionsByAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Account); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid account address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetReactionAccountKeyPrefix(req.Account))
+
+	var reactions []types.Reaction
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		postID := sdk.BigEndianToUint64(key[:8])
+		kind := types.ReactionKind(binary.BigEndian.Uint32(key[8:]))
+		reaction, found := k.GetReaction(ctx, postID, req.Account, kind)
+		if !found {
+			return status.Errorf(codes.Internal, "indexed reaction on post %d not found", postID)
+		}
+
+		reactions = append(reactions, reaction)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListReactionsByAccountResponse{Reactions: reactions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) ListReactionsByPost(goCtx context.Context, req *types.QueryListReactionsByPostRequest) (*types.QueryListReactionsByPostResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetReactionKeyPrefix(req.PostId))
+
+	var reactions []types.Reaction
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var reaction types.Reaction
+		if err := k.cdc.Unmarshal(value, &reaction); err != nil {
+			return err
+		}
+
+		reactions = append(reactions, reaction)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListReactionsByPostResponse{Reactions: reactions, Pagination: pageRes}, nil
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) ListReports(goCtx context.Context, req *types.QueryListReportsRequest) (*types.QueryListReportsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetReportKeyPrefix(req.PostId))
+
+	var reports []types.Report
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var report types.Report
+		if err := k.cdc.Unmarshal(value, &report); err != nil {
+			return err
+		}
+
+		reports = append(reports, report)
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListReportsResponse{Reports: reports, Pagination: pageRes}, nil
+}
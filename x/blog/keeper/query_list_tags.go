@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) ListTags(goCtx context.Context, req *types.QueryListTagsRequest) (*types.QueryListTagsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.TagCountKey))
+
+	var tags []types.TagCount
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		tags = append(tags, types.TagCount{Tag: string(key), Count: binary.BigEndian.Uint64(value)})
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListTagsResponse{Tags: tags, Pagination: pageRes}, nil
+}
@@ -19,9 +19,9 @@ func (k Keeper) ShowPost(goCtx context.Context, req *types.QueryShowPostRequest)
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	post, found := k.GetPost(ctx, req.Id)
-	if !found {
+	if !found || (post.Deleted && !req.IncludeDeleted) {
 		return nil, sdkerrors.ErrKeyNotFound
 	}
 
-	return &types.QueryShowPostResponse{Post: post}, nil
+	return &types.QueryShowPostResponse{Post: post, Reactions: k.GetReactionCounts(ctx, post.Id)}, nil
 }
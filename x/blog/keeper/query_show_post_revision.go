@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) ShowPostRevision(goCtx context.Context, req *types.QueryShowPostRevisionRequest) (*types.QueryShowPostRevisionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if post, found := k.GetPost(ctx, req.PostId); found && post.Hidden {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+	revision, found := k.GetPostRevision(ctx, req.PostId, req.Revision)
+	if !found {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	return &types.QueryShowPostRevisionResponse{Revision: revision}, nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+func (k Keeper) ShowPostTips(goCtx context.Context, req *types.QueryShowPostTipsRequest) (*types.QueryShowPostTipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	post, found := k.GetPost(ctx, req.PostId)
+	if !found || post.Deleted {
+		return nil, sdkerrors.ErrKeyNotFound
+	}
+
+	owner := k.PostOwner(ctx, post)
+	return &types.QueryShowPostTipsResponse{
+		PostId:     post.Id,
+		Tips:       post.Tips,
+		Author:     owner,
+		AuthorTips: k.GetAuthorTips(ctx, owner),
+	}, nil
+}
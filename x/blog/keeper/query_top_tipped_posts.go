@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"blog/x/blog/types"
+)
+
+// TopTippedPosts lists the published posts that received the most tips in a
+// denom, highest first.
+func (k Keeper) TopTippedPosts(goCtx context.Context, req *types.QueryTopTippedPostsRequest) (*types.QueryTopTippedPostsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetTipRankKeyPrefix(req.Denom))
+
+	pageReq := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pageReq = *req.Pagination
+	}
+	pageReq.Reverse = true
+
+	var posts []types.Post
+	pageRes, err := query.FilteredPaginate(store, pageReq, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		post, found := k.GetPost(ctx, sdk.BigEndianToUint64(key[len(key)-8:]))
+		if !found || post.Deleted || post.Hidden || post.Status != types.PostStatus_POST_STATUS_PUBLISHED {
+			return false, nil
+		}
+
+		if accumulate {
+			posts = append(posts, post)
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTopTippedPostsResponse{Post: posts, Pagination: pageRes}, nil
+}
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// GetReactionKeyPrefix returns the store prefix of the reactions on a post.
+func GetReactionKeyPrefix(postID uint64) []byte {
+	return append(types.KeyPrefix(types.ReactionKey), GetPostIDBytes(postID)...)
+}
+
+// GetReactionKindBytes returns the store key of a reaction kind.
+func GetReactionKindBytes(kind types.ReactionKind) []byte {
+	bz := make([]byte, 4)
+	binary.BigEndian.PutUint32(bz, uint32(kind))
+	return bz
+}
+
+// GetReactionKey returns the key of a reaction under the post prefix.
+func GetReactionKey(creator string, kind types.ReactionKind) []byte {
+	key := append([]byte(creator), []byte("/")...)
+	return append(key, GetReactionKindBytes(kind)...)
+}
+
+// GetReactionAccountKeyPrefix returns the store prefix indexing the reactions
+// left by an account.
+func GetReactionAccountKeyPrefix(creator string) []byte {
+	key := append(types.KeyPrefix(types.ReactionAccountKey), []byte(creator)...)
+	return append(key, []byte("/")...)
+}
+
+// GetReactionCountKeyPrefix returns the store prefix of the reaction counters
+// of a post.
+func GetReactionCountKeyPrefix(postID uint64) []byte {
+	return append(types.KeyPrefix(types.ReactionCountKey), GetPostIDBytes(postID)...)
+}
+
+// SetReaction stores a reaction, indexes it by account and counts it on the
+// post the first time it is set.
+func (k Keeper) SetReaction(ctx sdk.Context, reaction types.Reaction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetReactionKeyPrefix(reaction.PostId))
+	key := GetReactionKey(reaction.Creator, reaction.Kind)
+	if !store.Has(key) {
+		k.setReactionCount(ctx, reaction.PostId, reaction.Kind, k.getReactionCount(ctx, reaction.PostId, reaction.Kind)+1)
+	}
+	store.Set(key, k.cdc.MustMarshal(&reaction))
+
+	accountStore := prefix.NewStore(storeAdapter, GetReactionAccountKeyPrefix(reaction.Creator))
+	accountStore.Set(append(GetPostIDBytes(reaction.PostId), GetReactionKindBytes(reaction.Kind)...), []byte{})
+}
+
+func (k Keeper) GetReaction(ctx sdk.Context, postID uint64, creator string, kind types.ReactionKind) (val types.Reaction, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetReactionKeyPrefix(postID))
+	b := store.Get(GetReactionKey(creator, kind))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveReaction removes a reaction along with its account index entry and
+// decrements the counter of the post.
+func (k Keeper) RemoveReaction(ctx sdk.Context, postID uint64, creator string, kind types.ReactionKind) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetReactionKeyPrefix(postID))
+	key := GetReactionKey(creator, kind)
+	if !store.Has(key) {
+		return
+	}
+	store.Delete(key)
+	k.setReactionCount(ctx, postID, kind, k.getReactionCount(ctx, postID, kind)-1)
+
+	accountStore := prefix.NewStore(storeAdapter, GetReactionAccountKeyPrefix(creator))
+	accountStore.Delete(append(GetPostIDBytes(postID), GetReactionKindBytes(kind)...))
+}
+
+// RemovePostReactions removes every reaction left on a post and its counters.
+func (k Keeper) RemovePostReactions(ctx sdk.Context, postID uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetReactionKeyPrefix(postID))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var reactions []types.Reaction
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Reaction
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		reactions = append(reactions, val)
+	}
+	iterator.Close()
+
+	for _, reaction := range reactions {
+		k.RemoveReaction(ctx, reaction.PostId, reaction.Creator, reaction.Kind)
+	}
+}
+
+// GetReactionCounts returns the number of reactions of each kind left on a
+// post, ordered by kind. Kinds nobody used are left out.
+func (k Keeper) GetReactionCounts(ctx sdk.Context, postID uint64) (list []types.ReactionCount) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetReactionCountKeyPrefix(postID))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, types.ReactionCount{
+			Kind:  types.ReactionKind(binary.BigEndian.Uint32(iterator.Key())),
+			Count: binary.BigEndian.Uint64(iterator.Value()),
+		})
+	}
+
+	return
+}
+
+func (k Keeper) getReactionCount(ctx sdk.Context, postID uint64, kind types.ReactionKind) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetReactionCountKeyPrefix(postID))
+	bz := store.Get(GetReactionKindBytes(kind))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setReactionCount(ctx sdk.Context, postID uint64, kind types.ReactionKind, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetReactionCountKeyPrefix(postID))
+	if count == 0 {
+		store.Delete(GetReactionKindBytes(kind))
+		return
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(GetReactionKindBytes(kind), bz)
+}
+
+func (k Keeper) GetAllReaction(ctx sdk.Context) (list []types.Reaction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ReactionKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Reaction
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func TestPostReactions(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithBlockTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	creator, alice, bob := sample.AccAddress(), sample.AccAddress(), sample.AccAddress()
+	like, love := types.ReactionKind_REACTION_KIND_LIKE, types.ReactionKind_REACTION_KIND_LOVE
+
+	res, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "title"})
+	require.NoError(t, err)
+	other, err := ms.CreatePost(sdkCtx, &types.MsgCreatePost{Creator: creator, Title: "other"})
+	require.NoError(t, err)
+
+	_, err = ms.ReactToPost(sdkCtx, &types.MsgReactToPost{Creator: alice, PostId: 42, Kind: like})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+
+	_, err = ms.ReactToPost(sdkCtx, &types.MsgReactToPost{Creator: alice, PostId: res.Id, Kind: like})
+	require.NoError(t, err)
+	_, err = ms.ReactToPost(sdkCtx, &types.MsgReactToPost{Creator: alice, PostId: res.Id, Kind: like})
+	require.ErrorIs(t, err, types.ErrAlreadyReacted)
+	_, err = ms.ReactToPost(sdkCtx, &types.MsgReactToPost{Creator: alice, PostId: res.Id, Kind: love})
+	require.NoError(t, err)
+	_, err = ms.ReactToPost(sdkCtx, &types.MsgReactToPost{Creator: bob, PostId: res.Id, Kind: like})
+	require.NoError(t, err)
+	_, err = ms.ReactToPost(sdkCtx, &types.MsgReactToPost{Creator: alice, PostId: other.Id, Kind: like})
+	require.NoError(t, err)
+
+	show, err := k.ShowPost(sdkCtx, &types.QueryShowPostRequest{Id: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, []types.ReactionCount{{Kind: like, Count: 2}, {Kind: love, Count: 1}}, show.Reactions)
+
+	byPost, err := k.ListReactionsByPost(sdkCtx, &types.QueryListReactionsByPostRequest{PostId: res.Id})
+	require.NoError(t, err)
+	require.Len(t, byPost.Reactions, 3)
+	byAccount, err := k.ListReactionsByAccount(sdkCtx, &types.QueryListReactionsByAccountRequest{Account: alice})
+	require.NoError(t, err)
+	require.Len(t, byAccount.Reactions, 3)
+	require.Equal(t, sdkCtx.BlockTime(), byAccount.Reactions[0].CreatedAt)
+
+	_, err = ms.RemoveReaction(sdkCtx, &types.MsgRemoveReaction{Creator: bob, PostId: res.Id, Kind: love})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = ms.RemoveReaction(sdkCtx, &types.MsgRemoveReaction{Creator: alice, PostId: res.Id, Kind: like})
+	require.NoError(t, err)
+	show, err = k.ShowPost(sdkCtx, &types.QueryShowPostRequest{Id: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, []types.ReactionCount{{Kind: like, Count: 1}, {Kind: love, Count: 1}}, show.Reactions)
+	byAccount, err = k.ListReactionsByAccount(sdkCtx, &types.QueryListReactionsByAccountRequest{Account: alice})
+	require.NoError(t, err)
+	require.Len(t, byAccount.Reactions, 2)
+
+	// deleted posts take no new reactions and lose them all once purged
+	_, err = ms.DeletePost(sdkCtx, &types.MsgDeletePost{Creator: creator, Id: res.Id})
+	require.NoError(t, err)
+	_, err = ms.ReactToPost(sdkCtx, &types.MsgReactToPost{Creator: bob, PostId: res.Id, Kind: love})
+	require.ErrorIs(t, err, types.ErrPostDeleted)
+	k.PurgeExpiredPosts(sdkCtx.WithBlockTime(sdkCtx.BlockTime().Add(types.DefaultDeleteGracePeriod)))
+	require.Empty(t, k.GetReactionCounts(sdkCtx, res.Id))
+	byAccount, err = k.ListReactionsByAccount(sdkCtx, &types.QueryListReactionsByAccountRequest{Account: alice})
+	require.NoError(t, err)
+	require.Len(t, byAccount.Reactions, 1)
+	require.Equal(t, other.Id, byAccount.Reactions[0].PostId)
+}
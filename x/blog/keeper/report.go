@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// GetReportKeyPrefix returns the store prefix of the reports filed against a
+// post.
+func GetReportKeyPrefix(postID uint64) []byte {
+	return append(types.KeyPrefix(types.ReportKey), GetPostIDBytes(postID)...)
+}
+
+// SetReport stores a report under the post it was filed against and counts
+// the reporter if they had not reported the post before.
+func (k Keeper) SetReport(ctx sdk.Context, report types.Report) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetReportKeyPrefix(report.PostId))
+	if !store.Has([]byte(report.Reporter)) {
+		k.setPostReportCount(ctx, report.PostId, k.GetPostReportCount(ctx, report.PostId)+1)
+	}
+	store.Set([]byte(report.Reporter), k.cdc.MustMarshal(&report))
+}
+
+func (k Keeper) GetReport(ctx sdk.Context, postID uint64, reporter string) (val types.Report, found bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetReportKeyPrefix(postID))
+	b := store.Get([]byte(reporter))
+	if b == nil {
+		return val, false
+	}
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// GetAllReport returns every report filed against any post.
+func (k Keeper) GetAllReport(ctx sdk.Context) (list []types.Report) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ReportKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Report
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPostReportCount returns the number of distinct accounts that reported a
+// post.
+func (k Keeper) GetPostReportCount(ctx sdk.Context, postID uint64) uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ReportCountKey))
+	bz := store.Get(GetPostIDBytes(postID))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func (k Keeper) setPostReportCount(ctx sdk.Context, postID uint64, count uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.ReportCountKey))
+	if count == 0 {
+		store.Delete(GetPostIDBytes(postID))
+		return
+	}
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(GetPostIDBytes(postID), bz)
+}
+
+// RemovePostReports removes every report filed against a post.
+func (k Keeper) RemovePostReports(ctx sdk.Context, postID uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, GetReportKeyPrefix(postID))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	k.setPostReportCount(ctx, postID, 0)
+}
+
+// RecordReport stores a report and, once the number of reporters reaches the
+// report threshold, hides the post and queues it for review. It returns
+// whether the post was hidden.
+func (k Keeper) RecordReport(ctx sdk.Context, post types.Post, report types.Report) bool {
+	k.SetReport(ctx, report)
+
+	threshold := k.GetParams(ctx).ReportThreshold
+	if threshold == 0 || post.Hidden || post.Official || k.GetPostReportCount(ctx, post.Id) < threshold {
+		return false
+	}
+	post.Hidden = true
+	post.HideReason = report.Reason
+	post.HiddenBy = ""
+	k.SetPost(ctx, post)
+	k.InsertPostReviewQueue(ctx, post.Id)
+	return true
+}
+
+func (k Keeper) InsertPostReviewQueue(ctx sdk.Context, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostReviewQueueKey))
+	store.Set(GetPostIDBytes(id), []byte{})
+}
+
+func (k Keeper) RemoveFromPostReviewQueue(ctx sdk.Context, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostReviewQueueKey))
+	store.Delete(GetPostIDBytes(id))
+}
+
+// IsPostInReview reports whether a post is in the review queue.
+func (k Keeper) IsPostInReview(ctx sdk.Context, id uint64) bool {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostReviewQueueKey))
+	return store.Has(GetPostIDBytes(id))
+}
+
+// GetPostReviewQueue returns the IDs of the posts awaiting review.
+func (k Keeper) GetPostReviewQueue(ctx sdk.Context) []uint64 {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostReviewQueueKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	ids := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, sdk.BigEndianToUint64(iterator.Key()))
+	}
+	return ids
+}
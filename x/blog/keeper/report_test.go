@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func TestReportPost(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	owner, moderator := sample.AccAddress(), sample.AccAddress()
+	params := k.GetParams(ctx)
+	params.Moderators = []string{moderator}
+	params.ReportThreshold = 2
+	require.NoError(t, k.SetParams(ctx, params))
+	queued := func() []types.Post {
+		res, err := k.ListReviewQueue(ctx, &types.QueryListReviewQueueRequest{})
+		require.NoError(t, err)
+		return res.Post
+	}
+	reports := func(id uint64) []types.Report {
+		res, err := k.ListReports(ctx, &types.QueryListReportsRequest{PostId: id})
+		require.NoError(t, err)
+		return res.Reports
+	}
+	report := func(id uint64) *types.MsgReportPostResponse {
+		res, err := ms.ReportPost(ctx, &types.MsgReportPost{Creator: sample.AccAddress(), Id: id, Reason: types.HideReason_HIDE_REASON_SPAM})
+		require.NoError(t, err)
+		return res
+	}
+
+	created, err := ms.CreatePost(ctx, &types.MsgCreatePost{Creator: owner, Title: "title", Body: "body"})
+	require.NoError(t, err)
+	id := created.Id
+
+	reporter := sample.AccAddress()
+	res, err := ms.ReportPost(ctx, &types.MsgReportPost{Creator: reporter, Id: id, Reason: types.HideReason_HIDE_REASON_HARASSMENT})
+	require.NoError(t, err)
+	require.False(t, res.Hidden)
+	_, err = ms.ReportPost(ctx, &types.MsgReportPost{Creator: reporter, Id: id, Reason: types.HideReason_HIDE_REASON_SPAM})
+	require.ErrorIs(t, err, types.ErrAlreadyReported)
+	_, err = ms.ReportPost(ctx, &types.MsgReportPost{Creator: reporter, Id: id + 1, Reason: types.HideReason_HIDE_REASON_SPAM})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	require.Empty(t, queued())
+
+	// reaching the threshold hides the post and queues it for review
+	require.True(t, report(id).Hidden)
+	post, _ := k.GetPost(sdk.UnwrapSDKContext(ctx), id)
+	require.True(t, post.Hidden)
+	require.Equal(t, types.HideReason_HIDE_REASON_SPAM, post.HideReason)
+	require.Empty(t, post.HiddenBy)
+	require.Len(t, queued(), 1)
+	require.Len(t, reports(id), 2)
+	require.EqualValues(t, 2, k.GetPostReportCount(sdk.UnwrapSDKContext(ctx), id))
+	require.False(t, report(id).Hidden)
+	require.Len(t, queued(), 1)
+	require.EqualValues(t, 3, k.GetPostReportCount(sdk.UnwrapSDKContext(ctx), id))
+
+	_, err = ms.ReviewPost(ctx, &types.MsgReviewPost{Creator: owner, Id: id, Restore: true})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// restoring unhides the post and clears its reports
+	_, err = ms.ReviewPost(ctx, &types.MsgReviewPost{Creator: moderator, Id: id, Restore: true})
+	require.NoError(t, err)
+	post, _ = k.GetPost(sdk.UnwrapSDKContext(ctx), id)
+	require.False(t, post.Hidden)
+	require.Empty(t, queued())
+	require.Empty(t, reports(id))
+	require.Zero(t, k.GetPostReportCount(sdk.UnwrapSDKContext(ctx), id))
+	_, err = ms.ReviewPost(ctx, &types.MsgReviewPost{Creator: moderator, Id: id, Restore: true})
+	require.ErrorIs(t, err, types.ErrPostNotInReview)
+
+	// governance confirms the hide, which keeps the post hidden
+	report(id)
+	require.True(t, report(id).Hidden)
+	_, err = ms.ReviewPost(ctx, &types.MsgReviewPost{Creator: k.GetAuthority(), Id: id})
+	require.NoError(t, err)
+	post, _ = k.GetPost(sdk.UnwrapSDKContext(ctx), id)
+	require.True(t, post.Hidden)
+	require.Equal(t, k.GetAuthority(), post.HiddenBy)
+	require.Empty(t, queued())
+	require.Len(t, reports(id), 2)
+
+	// a zero threshold disables automatic hiding
+	params.ReportThreshold = 0
+	require.NoError(t, k.SetParams(ctx, params))
+	created, err = ms.CreatePost(ctx, &types.MsgCreatePost{Creator: owner, Title: "title", Body: "body"})
+	require.NoError(t, err)
+	require.False(t, report(created.Id).Hidden)
+	require.False(t, report(created.Id).Hidden)
+}
@@ -0,0 +1,35 @@
+package v10
+
+import (
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v9 to v10. The
+// migration sets the storage deposit per byte added to the params to its
+// default, which escrows nothing until governance changes it.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	var params types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	if params.DepositPerByte.Denom == "" {
+		params.DepositPerByte = types.DefaultDepositPerByte
+	}
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
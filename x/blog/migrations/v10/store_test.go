@@ -0,0 +1,40 @@
+package v10_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/keeper"
+	v10 "blog/x/blog/migrations/v10"
+	"blog/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, storeService, log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil)
+
+	// v9 params have no storage deposit
+	params := types.DefaultParams()
+	params.DepositPerByte = sdk.Coin{}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.NoError(t, v10.MigrateStore(ctx, storeService, cdc))
+
+	params = k.GetParams(ctx)
+	require.Equal(t, types.DefaultDepositPerByte, params.DepositPerByte)
+	require.Equal(t, types.DefaultPostFee, params.PostFee)
+}
@@ -0,0 +1,64 @@
+package v11
+
+import (
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/nft"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v10 to v11. The
+// migration creates the post NFT class and mints the NFT of every post that
+// is not deleted to its creator.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec, nftKeeper types.NFTKeeper) error {
+	storeAdapter := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	postStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
+
+	iterator := storetypes.KVStorePrefixIterator(postStore, []byte{})
+
+	var posts []types.Post
+	for ; iterator.Valid(); iterator.Next() {
+		var post types.Post
+		if err := cdc.Unmarshal(iterator.Value(), &post); err != nil {
+			iterator.Close()
+			return err
+		}
+		posts = append(posts, post)
+	}
+	iterator.Close()
+
+	if !nftKeeper.HasClass(ctx, types.PostNFTClassID) {
+		if err := nftKeeper.SaveClass(ctx, nft.Class{
+			Id:          types.PostNFTClassID,
+			Name:        types.PostNFTClassName,
+			Symbol:      types.PostNFTClassSymbol,
+			Description: "Ownership of blog posts",
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, post := range posts {
+		if post.Deleted || nftKeeper.HasNFT(ctx, types.PostNFTClassID, types.PostNFTID(post.Id)) {
+			continue
+		}
+		creator, err := sdk.AccAddressFromBech32(post.Creator)
+		if err != nil {
+			return err
+		}
+		token := nft.NFT{
+			ClassId: types.PostNFTClassID,
+			Id:      types.PostNFTID(post.Id),
+		}
+		if err := nftKeeper.Mint(ctx, token, creator); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package v11_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "blog/testutil/keeper"
+	"blog/testutil/sample"
+	"blog/x/blog/keeper"
+	v11 "blog/x/blog/migrations/v11"
+	"blog/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	nftKeeper := keepertest.NewMockNFTKeeper()
+	k := keeper.NewKeeper(cdc, storeService, log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nftKeeper)
+
+	// v10 posts have no NFT
+	creator := sample.AccAddress()
+	live := k.AppendPost(ctx, types.Post{Creator: creator, Title: "live"})
+	deleted := k.AppendPost(ctx, types.Post{Creator: creator, Title: "deleted", Deleted: true})
+
+	require.NoError(t, v11.MigrateStore(ctx, storeService, cdc, nftKeeper))
+
+	require.True(t, nftKeeper.HasClass(ctx, types.PostNFTClassID))
+	require.True(t, k.HasPostNFT(ctx, live))
+	require.False(t, k.HasPostNFT(ctx, deleted))
+	post, _ := k.GetPost(ctx, live)
+	require.Equal(t, creator, k.PostOwner(ctx, post))
+
+	// running it again leaves the minted NFTs in place
+	require.NoError(t, v11.MigrateStore(ctx, storeService, cdc, nftKeeper))
+}
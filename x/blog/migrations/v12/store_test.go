@@ -0,0 +1,44 @@
+package v12_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/keeper"
+	v12 "blog/x/blog/migrations/v12"
+	"blog/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, storeService, log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil)
+
+	// v11 posts have no original author
+	creator, author := sample.AccAddress(), sample.AccAddress()
+	k.AppendPost(ctx, types.Post{Creator: creator, Title: "title"})
+	k.AppendPost(ctx, types.Post{Creator: creator, OriginalAuthor: author, Title: "transferred"})
+
+	require.NoError(t, v12.MigrateStore(ctx, storeService, cdc))
+
+	post, found := k.GetPost(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, creator, post.OriginalAuthor)
+
+	post, found = k.GetPost(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, author, post.OriginalAuthor)
+}
@@ -0,0 +1,39 @@
+package v13_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/keeper"
+	v13 "blog/x/blog/migrations/v13"
+	"blog/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, storeService, log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil)
+
+	// v12 params have no report threshold
+	params := types.DefaultParams()
+	params.ReportThreshold = 0
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.NoError(t, v13.MigrateStore(ctx, storeService, cdc))
+
+	params = k.GetParams(ctx)
+	require.Equal(t, types.DefaultReportThreshold, params.ReportThreshold)
+	require.Equal(t, types.DefaultMaxRevisions, params.MaxRevisions)
+}
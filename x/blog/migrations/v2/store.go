@@ -0,0 +1,56 @@
+package v2
+
+import (
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2. The
+// migration backfills the creation and update timestamps and heights of
+// every existing post. The original values were never recorded, so the
+// block time and height of the migration are used instead.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	storeAdapter := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	postStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
+
+	iterator := storetypes.KVStorePrefixIterator(postStore, []byte{})
+
+	var (
+		keys  [][]byte
+		posts []types.Post
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		var post types.Post
+		if err := cdc.Unmarshal(iterator.Value(), &post); err != nil {
+			iterator.Close()
+			return err
+		}
+		if post.CreatedHeight != 0 {
+			continue
+		}
+		post.CreatedAt = ctx.BlockTime()
+		post.CreatedHeight = ctx.BlockHeight()
+		post.UpdatedAt = ctx.BlockTime()
+		post.UpdatedHeight = ctx.BlockHeight()
+
+		keys = append(keys, iterator.Key())
+		posts = append(posts, post)
+	}
+	iterator.Close()
+
+	for i, post := range posts {
+		bz, err := cdc.Marshal(&post)
+		if err != nil {
+			return err
+		}
+		postStore.Set(keys[i], bz)
+	}
+
+	return nil
+}
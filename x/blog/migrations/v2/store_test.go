@@ -0,0 +1,44 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/keeper"
+	v2 "blog/x/blog/migrations/v2"
+	"blog/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, storeService, log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil)
+
+	// a v1 post carries no timestamps or heights
+	k.AppendPost(ctx, types.Post{Title: "title", Body: "body"})
+
+	blockTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime).WithBlockHeight(42)
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	post, found := k.GetPost(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, "title", post.Title)
+	require.Equal(t, blockTime, post.CreatedAt)
+	require.Equal(t, int64(42), post.CreatedHeight)
+	require.Equal(t, blockTime, post.UpdatedAt)
+	require.Equal(t, int64(42), post.UpdatedHeight)
+}
@@ -0,0 +1,36 @@
+package v3
+
+import (
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3. The
+// migration sets the revision limit added to the params to its default. v2
+// params have no such field, so zero here means unset rather than a chosen
+// "no history".
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	var params types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	if params.MaxRevisions == 0 {
+		params.MaxRevisions = types.DefaultMaxRevisions
+	}
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
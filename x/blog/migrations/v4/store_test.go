@@ -0,0 +1,38 @@
+package v4_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/keeper"
+	v4 "blog/x/blog/migrations/v4"
+	"blog/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, storeService, log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil)
+
+	// v3 params have no restore window
+	require.NoError(t, k.SetParams(ctx, types.Params{MaxRevisions: 3}))
+
+	require.NoError(t, v4.MigrateStore(ctx, storeService, cdc))
+
+	params := k.GetParams(ctx)
+	require.Equal(t, types.DefaultDeleteGracePeriod, params.DeleteGracePeriod)
+	require.Equal(t, uint64(3), params.MaxRevisions)
+	require.Zero(t, params.MaxCommentDepth)
+}
@@ -0,0 +1,39 @@
+package v5_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/keeper"
+	v5 "blog/x/blog/migrations/v5"
+	"blog/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, storeService, log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil)
+
+	// v4 params have no reply depth limit
+	require.NoError(t, k.SetParams(ctx, types.Params{MaxRevisions: 3, DeleteGracePeriod: time.Hour}))
+
+	require.NoError(t, v5.MigrateStore(ctx, storeService, cdc))
+
+	params := k.GetParams(ctx)
+	require.Equal(t, types.DefaultMaxCommentDepth, params.MaxCommentDepth)
+	require.Equal(t, uint64(3), params.MaxRevisions)
+	require.Equal(t, time.Hour, params.DeleteGracePeriod)
+}
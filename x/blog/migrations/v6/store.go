@@ -0,0 +1,41 @@
+package v6
+
+import (
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6. The
+// migration builds the creator index for every existing post.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	storeAdapter := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	postStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
+
+	iterator := storetypes.KVStorePrefixIterator(postStore, []byte{})
+
+	var posts []types.Post
+	for ; iterator.Valid(); iterator.Next() {
+		var post types.Post
+		if err := cdc.Unmarshal(iterator.Value(), &post); err != nil {
+			iterator.Close()
+			return err
+		}
+		posts = append(posts, post)
+	}
+	iterator.Close()
+
+	for _, post := range posts {
+		key := append(types.KeyPrefix(types.PostCreatorKey), []byte(post.Creator)...)
+		key = append(key, []byte("/")...)
+		key = append(key, sdk.Uint64ToBigEndian(post.Id)...)
+		storeAdapter.Set(key, []byte{})
+	}
+
+	return nil
+}
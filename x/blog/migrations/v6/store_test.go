@@ -0,0 +1,55 @@
+package v6_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/keeper"
+	v6 "blog/x/blog/migrations/v6"
+	"blog/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, storeService, log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil)
+
+	// v5 posts are stored without a creator index
+	creator := sample.AccAddress()
+	store := prefix.NewStore(runtime.KVStoreAdapter(storeService.OpenKVStore(ctx)), types.KeyPrefix(types.PostKey))
+	for id := uint64(0); id < 2; id++ {
+		post := types.Post{Id: id, Creator: creator, Title: "title"}
+		store.Set(keeper.GetPostIDBytes(id), cdc.MustMarshal(&post))
+	}
+	k.SetPostCount(ctx, 2)
+
+	indexed := func() int {
+		index := prefix.NewStore(runtime.KVStoreAdapter(storeService.OpenKVStore(ctx)), keeper.GetPostCreatorKeyPrefix(creator))
+		iterator := index.Iterator(nil, nil)
+		defer iterator.Close()
+		count := 0
+		for ; iterator.Valid(); iterator.Next() {
+			count++
+		}
+		return count
+	}
+	require.Zero(t, indexed())
+
+	require.NoError(t, v6.MigrateStore(ctx, storeService, cdc))
+
+	require.Equal(t, 2, indexed())
+}
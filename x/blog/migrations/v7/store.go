@@ -0,0 +1,53 @@
+package v7
+
+import (
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. Every post
+// written before post statuses existed was public, so the migration marks
+// them published as of their creation time.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	storeAdapter := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+	postStore := prefix.NewStore(storeAdapter, types.KeyPrefix(types.PostKey))
+
+	iterator := storetypes.KVStorePrefixIterator(postStore, []byte{})
+
+	var (
+		keys  [][]byte
+		posts []types.Post
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		var post types.Post
+		if err := cdc.Unmarshal(iterator.Value(), &post); err != nil {
+			iterator.Close()
+			return err
+		}
+		if post.Status != types.PostStatus_POST_STATUS_UNSPECIFIED {
+			continue
+		}
+		post.Status = types.PostStatus_POST_STATUS_PUBLISHED
+		post.PublishAt = post.CreatedAt
+
+		keys = append(keys, iterator.Key())
+		posts = append(posts, post)
+	}
+	iterator.Close()
+
+	for i, post := range posts {
+		bz, err := cdc.Marshal(&post)
+		if err != nil {
+			return err
+		}
+		postStore.Set(keys[i], bz)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package v7_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/keeper"
+	v7 "blog/x/blog/migrations/v7"
+	"blog/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, storeService, log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil)
+
+	// a v6 post carries no status
+	createdAt := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	k.AppendPost(ctx, types.Post{Title: "title", CreatedAt: createdAt})
+	k.AppendPost(ctx, types.Post{Title: "draft", Status: types.PostStatus_POST_STATUS_DRAFT})
+
+	require.NoError(t, v7.MigrateStore(ctx, storeService, cdc))
+
+	post, found := k.GetPost(ctx, 0)
+	require.True(t, found)
+	require.Equal(t, types.PostStatus_POST_STATUS_PUBLISHED, post.Status)
+	require.Equal(t, createdAt, post.PublishAt)
+
+	post, found = k.GetPost(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, types.PostStatus_POST_STATUS_DRAFT, post.Status)
+	require.True(t, post.PublishAt.IsZero())
+}
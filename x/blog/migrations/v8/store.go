@@ -0,0 +1,69 @@
+package v8
+
+import (
+	"reflect"
+
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"blog/x/blog/types"
+)
+
+// MigrateStore performs in-place store migrations from v7 to v8. The
+// migration sets the content limits added to the params to their defaults,
+// along with the tag limits if the chain never set them.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	store := runtime.KVStoreAdapter(storeService.OpenKVStore(ctx))
+
+	var params types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+
+	if params.MaxTitleBytes == 0 {
+		params.MaxTitleBytes = types.DefaultMaxTitleBytes
+	}
+	if params.MaxBodyBytes == 0 {
+		params.MaxBodyBytes = types.DefaultMaxBodyBytes
+	}
+	if params.MaxTagLength == 0 {
+		params.MaxTags = types.DefaultMaxTags
+		params.MaxTagLength = types.DefaultMaxTagLength
+	}
+	if err := validateLimits(params); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
+
+// validateLimits runs the param validators of the limits this migration sets.
+// The other params are left to the migrations that introduce them, so they
+// are not validated here.
+func validateLimits(params types.Params) error {
+	keys := map[string]bool{
+		string(types.KeyMaxTags):       true,
+		string(types.KeyMaxTagLength):  true,
+		string(types.KeyMaxTitleBytes): true,
+		string(types.KeyMaxBodyBytes):  true,
+	}
+	for _, pair := range params.ParamSetPairs() {
+		if !keys[string(pair.Key)] {
+			continue
+		}
+		if err := pair.ValidatorFn(reflect.ValueOf(pair.Value).Elem().Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
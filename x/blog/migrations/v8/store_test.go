@@ -0,0 +1,41 @@
+package v8_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/keeper"
+	v8 "blog/x/blog/migrations/v8"
+	"blog/x/blog/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(cdc, storeService, log.NewNopLogger(), authtypes.NewModuleAddress(govtypes.ModuleName).String(), nil, nil)
+
+	// v7 params have no content limits
+	require.NoError(t, k.SetParams(ctx, types.Params{MaxRevisions: 3, MaxTags: 1, MaxTagLength: 8}))
+
+	require.NoError(t, v8.MigrateStore(ctx, storeService, cdc))
+
+	params := k.GetParams(ctx)
+	require.Equal(t, uint64(3), params.MaxRevisions)
+	require.Equal(t, uint64(1), params.MaxTags)
+	require.Equal(t, uint64(8), params.MaxTagLength)
+	require.Equal(t, types.DefaultMaxTitleBytes, params.MaxTitleBytes)
+	require.Equal(t, types.DefaultMaxBodyBytes, params.MaxBodyBytes)
+	require.Empty(t, params.PostFee.Denom)
+}
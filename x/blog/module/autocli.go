@@ -31,6 +31,111 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
 				},
 
+				{
+					RpcMethod:      "ListPostRevisions",
+					Use:            "list-post-revisions [post-id]",
+					Short:          "Query list-post-revisions",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}},
+				},
+
+				{
+					RpcMethod:      "ShowPostRevision",
+					Use:            "show-post-revision [post-id] [revision]",
+					Short:          "Query show-post-revision",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}, {ProtoField: "revision"}},
+				},
+
+				{
+					RpcMethod:      "ListCommentsByPost",
+					Use:            "list-comments-by-post [post-id]",
+					Short:          "Query list-comments-by-post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}},
+				},
+
+				{
+					RpcMethod:      "CommentThread",
+					Use:            "comment-thread [post-id] [comment-id]",
+					Short:          "Query comment-thread",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}, {ProtoField: "comment_id"}},
+				},
+
+				{
+					RpcMethod:      "ListPostsByCreator",
+					Use:            "list-posts-by-creator [creator]",
+					Short:          "Query list-posts-by-creator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+
+				{
+					RpcMethod:      "ListPostsByTag",
+					Use:            "list-posts-by-tag [tag]",
+					Short:          "Query list-posts-by-tag",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tag"}},
+				},
+
+				{
+					RpcMethod:      "ListTags",
+					Use:            "list-tags",
+					Short:          "Query list-tags",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{},
+				},
+
+				{
+					RpcMethod:      "ListReactionsByPost",
+					Use:            "list-reactions-by-post [post-id]",
+					Short:          "Query list-reactions-by-post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}},
+				},
+
+				{
+					RpcMethod:      "ListReactionsByAccount",
+					Use:            "list-reactions-by-account [account]",
+					Short:          "Query list-reactions-by-account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account"}},
+				},
+
+				{
+					RpcMethod:      "ShowPostTips",
+					Use:            "show-post-tips [post-id]",
+					Short:          "Query show-post-tips",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}},
+				},
+
+				{
+					RpcMethod:      "TopTippedPosts",
+					Use:            "top-tipped-posts [denom]",
+					Short:          "Query top-tipped-posts",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+
+				{
+					RpcMethod:      "ListPostsByEditor",
+					Use:            "list-posts-by-editor [editor]",
+					Short:          "Query list-posts-by-editor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "editor"}},
+				},
+				{
+					RpcMethod:      "ListReports",
+					Use:            "list-reports [post-id]",
+					Short:          "Query the reports filed against a post",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}},
+				},
+				{
+					RpcMethod: "ListReviewQueue",
+					Use:       "list-review-queue",
+					Short:     "Query the reported posts awaiting review",
+				},
+				{
+					RpcMethod: "ListAnnouncements",
+					Use:       "list-announcements",
+					Short:     "Query the official announcements created by governance",
+				},
+				{
+					RpcMethod: "ListFeaturedPosts",
+					Use:       "list-featured-posts",
+					Short:     "Query the posts featured by governance, in order",
+				},
+
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -42,17 +147,29 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "UpdateBlocklist",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "CreateAnnouncement",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetFeaturedPosts",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod:      "CreatePost",
-					Use:            "create-post [title] [body]",
+					Use:            "create-post [title] [body] [tags]...",
 					Short:          "Send a create-post tx",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "title"}, {ProtoField: "body"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "title"}, {ProtoField: "body"}, {ProtoField: "tags", Varargs: true}},
 				},
 				{
 					RpcMethod:      "UpdatePost",
-					Use:            "update-post [title] [body] [id]",
+					Use:            "update-post [title] [body] [id] [tags]...",
 					Short:          "Send a update-post tx",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "title"}, {ProtoField: "body"}, {ProtoField: "id"}},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "title"}, {ProtoField: "body"}, {ProtoField: "id"}, {ProtoField: "tags", Varargs: true}},
 				},
 				{
 					RpcMethod:      "DeletePost",
@@ -60,6 +177,114 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a delete-post tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
 				},
+				{
+					RpcMethod:      "RestorePost",
+					Use:            "restore-post [id]",
+					Short:          "Send a restore-post tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "CreateComment",
+					Use:            "create-comment [post-id] [body]",
+					Short:          "Send a create-comment tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}, {ProtoField: "body"}},
+				},
+				{
+					RpcMethod:      "UpdateComment",
+					Use:            "update-comment [post-id] [id] [body]",
+					Short:          "Send a update-comment tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}, {ProtoField: "id"}, {ProtoField: "body"}},
+				},
+				{
+					RpcMethod:      "DeleteComment",
+					Use:            "delete-comment [post-id] [id]",
+					Short:          "Send a delete-comment tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}, {ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ReplyComment",
+					Use:            "reply-comment [post-id] [parent-id] [body]",
+					Short:          "Send a reply-comment tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}, {ProtoField: "parent_id"}, {ProtoField: "body"}},
+				},
+				{
+					RpcMethod:      "ReactToPost",
+					Use:            "react-to-post [post-id] [kind]",
+					Short:          "Send a react-to-post tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}, {ProtoField: "kind"}},
+				},
+				{
+					RpcMethod:      "RemoveReaction",
+					Use:            "remove-reaction [post-id] [kind]",
+					Short:          "Send a remove-reaction tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}, {ProtoField: "kind"}},
+				},
+				{
+					RpcMethod:      "PublishPost",
+					Use:            "publish-post [id]",
+					Short:          "Send a publish-post tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "TipPost",
+					Use:            "tip-post [post-id] [amount]",
+					Short:          "Send a tip-post tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "post_id"}, {ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod:      "TransferPost",
+					Use:            "transfer-post [id] [new-owner]",
+					Short:          "Send a transfer-post tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "new_owner"}},
+				},
+				{
+					RpcMethod:      "AcceptPostTransfer",
+					Use:            "accept-post-transfer [id]",
+					Short:          "Send a accept-post-transfer tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "MigrateAuthor",
+					Use:            "migrate-author [new-author]",
+					Short:          "Send a migrate-author tx, to be signed by both the old and new author",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "new_author"}},
+				},
+				{
+					RpcMethod:      "AddEditor",
+					Use:            "add-editor [id] [editor]",
+					Short:          "Send a add-editor tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "editor"}},
+				},
+				{
+					RpcMethod:      "RemoveEditor",
+					Use:            "remove-editor [id] [editor]",
+					Short:          "Send a remove-editor tx",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "editor"}},
+				},
+				{
+					RpcMethod:      "HidePost",
+					Use:            "hide-post [id] [reason]",
+					Short:          "Hide a post as a moderator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "UnhidePost",
+					Use:            "unhide-post [id]",
+					Short:          "Unhide a post as a moderator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "ReportPost",
+					Use:            "report-post [id] [reason]",
+					Short:          "Report a post for moderation",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}, {ProtoField: "reason"}},
+				},
+				{
+					RpcMethod:      "ReviewPost",
+					Use:            "review-post [id]",
+					Short:          "Confirm the hide of a reported post, or restore it with --restore",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -9,6 +9,79 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// Set all the post
+	for _, elem := range genState.PostList {
+		k.SetPost(ctx, elem)
+		if elem.Deleted {
+			k.InsertPostPurgeQueue(ctx, elem.PurgeAt, elem.Id)
+		}
+		if keeper.IsScheduledPost(elem) {
+			k.InsertPostPublishQueue(ctx, elem.PublishAt, elem.Id)
+		}
+	}
+
+	// Set post count
+	k.SetPostCount(ctx, genState.PostCount)
+
+	// Create the post NFT class and mint the NFTs missing from the nft genesis
+	if err := k.InitPostNFTClass(ctx); err != nil {
+		panic(err)
+	}
+	for _, elem := range genState.PostList {
+		if elem.Deleted || k.HasPostNFT(ctx, elem.Id) {
+			continue
+		}
+		if err := k.MintPostNFT(ctx, elem.Id, elem.Creator); err != nil {
+			panic(err)
+		}
+	}
+
+	// Set all the post revisions
+	for _, elem := range genState.PostRevisionList {
+		k.SetPostRevision(ctx, elem)
+	}
+
+	// Set all the comment
+	for _, elem := range genState.CommentList {
+		k.SetComment(ctx, elem)
+		if elem.Depth > 0 {
+			k.SetCommentReply(ctx, elem)
+		}
+	}
+
+	// Set comment count
+	k.SetCommentCount(ctx, genState.CommentCount)
+
+	// Set all the reactions
+	for _, elem := range genState.ReactionList {
+		k.SetReaction(ctx, elem)
+	}
+
+	// Set all the author tips
+	for _, elem := range genState.AuthorTipsList {
+		k.SetAuthorTips(ctx, elem)
+	}
+
+	// Set all the author migrations in progress
+	for _, elem := range genState.AuthorMigrationList {
+		k.SetAuthorMigration(ctx, elem)
+	}
+
+	// Set all the reports and the posts awaiting review
+	for _, elem := range genState.ReportList {
+		k.SetReport(ctx, elem)
+	}
+	for _, id := range genState.ReviewQueue {
+		k.InsertPostReviewQueue(ctx, id)
+	}
+
+	// Set the blocklist
+	for _, address := range genState.Blocklist {
+		k.BlockAddress(ctx, address)
+	}
+
+	// Set the featured posts
+	k.ReplaceFeaturedPosts(ctx, genState.FeaturedPostList)
 	// this line is used by starport scaffolding # genesis/module/init
 	if err := k.SetParams(ctx, genState.Params); err != nil {
 		panic(err)
@@ -20,6 +93,26 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	genesis.PostList = k.GetAllPost(ctx)
+	genesis.PostCount = k.GetPostCount(ctx)
+
+	genesis.PostRevisionList = k.GetAllPostRevision(ctx)
+
+	genesis.CommentList = k.GetAllComment(ctx)
+	genesis.CommentCount = k.GetCommentCount(ctx)
+
+	genesis.ReactionList = k.GetAllReaction(ctx)
+
+	genesis.AuthorTipsList = k.GetAllAuthorTips(ctx)
+
+	genesis.AuthorMigrationList = k.GetAllAuthorMigration(ctx)
+
+	genesis.ReportList = k.GetAllReport(ctx)
+	genesis.ReviewQueue = k.GetPostReviewQueue(ctx)
+
+	genesis.Blocklist = k.GetBlocklist(ctx)
+
+	genesis.FeaturedPostList = k.GetAllFeaturedPost(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
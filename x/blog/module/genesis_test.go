@@ -5,6 +5,7 @@ import (
 
 	keepertest "blog/testutil/keeper"
 	"blog/testutil/nullify"
+	"blog/testutil/sample"
 	blog "blog/x/blog/module"
 	"blog/x/blog/types"
 
@@ -15,6 +16,82 @@ func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
 
+		PostList: []types.Post{
+			{
+				Id:       0,
+				Creator:  sample.AccAddress(),
+				Revision: 1,
+			},
+			{
+				Id:      1,
+				Creator: sample.AccAddress(),
+				Hidden:  true,
+			},
+		},
+		PostCount: 2,
+		PostRevisionList: []types.PostRevision{
+			{
+				PostId:   0,
+				Revision: 0,
+			},
+		},
+		CommentList: []types.Comment{
+			{
+				PostId: 0,
+				Id:     0,
+			},
+			{
+				PostId: 1,
+				Id:     1,
+			},
+			{
+				PostId:   1,
+				Id:       2,
+				ParentId: 1,
+				Depth:    1,
+			},
+		},
+		CommentCount: 3,
+		ReactionList: []types.Reaction{
+			{
+				PostId:  0,
+				Creator: "alice",
+				Kind:    types.ReactionKind_REACTION_KIND_LIKE,
+			},
+			{
+				PostId:  1,
+				Creator: "alice",
+				Kind:    types.ReactionKind_REACTION_KIND_LIKE,
+			},
+		},
+		AuthorTipsList: []types.AuthorTips{
+			{
+				Author: "alice",
+			},
+		},
+		AuthorMigrationList: []types.AuthorMigration{
+			{
+				OldAuthor: "alice",
+				NewAuthor: "bob",
+			},
+		},
+		ReportList: []types.Report{
+			{
+				PostId:   1,
+				Reporter: "alice",
+				Reason:   types.HideReason_HIDE_REASON_SPAM,
+			},
+		},
+		ReviewQueue: []uint64{1},
+		Blocklist:   []string{sample.AccAddress()},
+		FeaturedPostList: []types.FeaturedPost{
+			{
+				PostId: 1,
+			},
+			{
+				PostId: 0,
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -23,8 +100,27 @@ func TestGenesis(t *testing.T) {
 	got := blog.ExportGenesis(ctx, k)
 	require.NotNil(t, got)
 
+	for _, post := range genesisState.PostList {
+		require.Equal(t, post.Creator, k.PostOwner(ctx, post))
+	}
+
 	nullify.Fill(&genesisState)
 	nullify.Fill(got)
 
+	require.ElementsMatch(t, genesisState.PostList, got.PostList)
+	require.Equal(t, genesisState.PostCount, got.PostCount)
+	require.ElementsMatch(t, genesisState.PostRevisionList, got.PostRevisionList)
+	require.ElementsMatch(t, genesisState.CommentList, got.CommentList)
+	require.Equal(t, genesisState.CommentCount, got.CommentCount)
+	require.Equal(t, []uint64{2}, k.GetCommentReplyIDs(ctx, 1, 1))
+	require.ElementsMatch(t, genesisState.ReactionList, got.ReactionList)
+	require.Equal(t, []types.ReactionCount{{Kind: types.ReactionKind_REACTION_KIND_LIKE, Count: 1}}, k.GetReactionCounts(ctx, 0))
+	require.ElementsMatch(t, genesisState.AuthorTipsList, got.AuthorTipsList)
+	require.ElementsMatch(t, genesisState.AuthorMigrationList, got.AuthorMigrationList)
+	require.ElementsMatch(t, genesisState.ReportList, got.ReportList)
+	require.EqualValues(t, 1, k.GetPostReportCount(ctx, 1))
+	require.Equal(t, genesisState.ReviewQueue, got.ReviewQueue)
+	require.Equal(t, genesisState.Blocklist, got.Blocklist)
+	require.Equal(t, genesisState.FeaturedPostList, got.FeaturedPostList)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,86 @@
+package blog
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps the ICS-20 transfer module and turns incoming transfers
+// carrying a tip memo into post tips. Transfers without a usable memo are
+// passed through untouched.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the given transfer
+// application.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket redirects the tokens of a tip transfer to the module account,
+// lets the transfer application mint or unescrow them there and then forwards
+// them to the creator of the tipped post.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	postID, ok := types.ParseTipMemo(data.Memo)
+	if !ok {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	post, found := im.keeper.GetPost(ctx, postID)
+	if !found || post.Deleted {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok || !amount.IsPositive() {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	data.Receiver = authtypes.NewModuleAddress(types.ModuleName).String()
+	packet.Data = data.GetBytes()
+	ack := im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	tip := sdk.NewCoins(sdk.NewCoin(receivedDenom(packet, data.Denom), amount))
+	if err := im.keeper.ForwardPostTip(ctx, post, data.Sender, tip); err != nil {
+		return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error()))
+	}
+	return ack
+}
+
+// receivedDenom returns the local denom of the tokens credited by a transfer
+// packet, following the rules of the transfer keeper.
+func receivedDenom(packet channeltypes.Packet, denom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), denom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(denom[len(voucherPrefix):]).IBCDenom()
+	}
+	prefixedDenom := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), denom)
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
@@ -0,0 +1,81 @@
+package blog_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	keepertest "blog/testutil/keeper"
+	"blog/testutil/sample"
+	blog "blog/x/blog/module"
+	"blog/x/blog/types"
+)
+
+// mockTransferModule credits the packet receiver the way the transfer
+// application does for tokens arriving from a counterparty chain.
+type mockTransferModule struct {
+	porttypes.IBCModule
+
+	bank     *keepertest.MockBankKeeper
+	received []transfertypes.FungibleTokenPacketData
+}
+
+func (m *mockTransferModule) OnRecvPacket(_ sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	transfertypes.ModuleCdc.MustUnmarshalJSON(packet.GetData(), &data)
+	m.received = append(m.received, data)
+
+	amount, _ := sdkmath.NewIntFromString(data.Amount)
+	prefixed := transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), data.Denom)
+	denom := transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+	m.bank.Fund(sdk.MustAccAddressFromBech32(data.Receiver), sdk.NewCoins(sdk.NewCoin(denom, amount)))
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func TestIBCMiddlewareTip(t *testing.T) {
+	k, bank, ctx := keepertest.BlogKeeperWithBank(t)
+	transfer := &mockTransferModule{bank: bank}
+	middleware := blog.NewIBCMiddleware(transfer, k)
+
+	author, receiver := sample.AccAddress(), sample.AccAddress()
+	id := k.AppendPost(ctx, types.Post{Creator: author, Title: "title"})
+	deleted := k.AppendPost(ctx, types.Post{Creator: author, Title: "gone", Deleted: true})
+	voucher := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-1", "uatom")).IBCDenom()
+
+	recv := func(memo string) ibcexported.Acknowledgement {
+		data := transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", receiver, memo)
+		packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(0, 100), 0)
+		return middleware.OnRecvPacket(ctx, packet, nil)
+	}
+
+	ack := recv(`{"blog":{"tip_post":0}}`)
+	require.True(t, ack.Success())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(voucher, 100)), bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(author)))
+	require.True(t, bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(receiver)).IsZero())
+	require.True(t, bank.ModuleBalance(types.ModuleName).IsZero())
+
+	post, found := k.GetPost(ctx, id)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(voucher, 100)), post.Tips)
+	require.Equal(t, post.Tips, k.GetAuthorTips(ctx, author))
+
+	// transfers that cannot be turned into a tip reach their receiver
+	for _, memo := range []string{"", "not json", `{"blog":{"tip_post":99}}`, `{"blog":{"tip_post":1}}`} {
+		ack = recv(memo)
+		require.True(t, ack.Success())
+	}
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(voucher, 400)), bank.SpendableCoins(ctx, sdk.MustAccAddressFromBech32(receiver)))
+	post, _ = k.GetPost(ctx, deleted)
+	require.True(t, post.Tips.IsZero())
+	require.Len(t, transfer.received, 5)
+	for _, data := range transfer.received[1:] {
+		require.Equal(t, receiver, data.Receiver)
+	}
+}
@@ -35,6 +35,78 @@ const (
 	// TODO: Determine the simulation weight value
 	defaultWeightMsgDeletePost int = 100
 
+	opWeightMsgRestorePost = "op_weight_msg_restore_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRestorePost int = 100
+
+	opWeightMsgCreateComment = "op_weight_msg_create_comment"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgCreateComment int = 100
+
+	opWeightMsgUpdateComment = "op_weight_msg_update_comment"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUpdateComment int = 100
+
+	opWeightMsgDeleteComment = "op_weight_msg_delete_comment"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgDeleteComment int = 100
+
+	opWeightMsgReplyComment = "op_weight_msg_reply_comment"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgReplyComment int = 100
+
+	opWeightMsgReactToPost = "op_weight_msg_react_to_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgReactToPost int = 100
+
+	opWeightMsgRemoveReaction = "op_weight_msg_remove_reaction"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRemoveReaction int = 100
+
+	opWeightMsgPublishPost = "op_weight_msg_publish_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgPublishPost int = 100
+
+	opWeightMsgTipPost = "op_weight_msg_tip_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgTipPost int = 100
+
+	opWeightMsgTransferPost = "op_weight_msg_transfer_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgTransferPost int = 100
+
+	opWeightMsgAcceptPostTransfer = "op_weight_msg_accept_post_transfer"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAcceptPostTransfer int = 100
+
+	opWeightMsgMigrateAuthor = "op_weight_msg_migrate_author"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgMigrateAuthor int = 100
+
+	opWeightMsgAddEditor = "op_weight_msg_add_editor"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgAddEditor int = 100
+
+	opWeightMsgRemoveEditor = "op_weight_msg_remove_editor"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgRemoveEditor int = 100
+
+	opWeightMsgHidePost = "op_weight_msg_hide_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgHidePost int = 100
+
+	opWeightMsgUnhidePost = "op_weight_msg_unhide_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgUnhidePost int = 100
+
+	opWeightMsgReportPost = "op_weight_msg_report_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgReportPost int = 100
+
+	opWeightMsgReviewPost = "op_weight_msg_review_post"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsgReviewPost int = 100
+
 	// this line is used by starport scaffolding # simapp/module/const
 )
 
@@ -46,6 +118,17 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	}
 	blogGenesis := types.GenesisState{
 		Params: types.DefaultParams(),
+		PostList: []types.Post{
+			{
+				Id:      0,
+				Creator: sample.AccAddress(),
+			},
+			{
+				Id:      1,
+				Creator: sample.AccAddress(),
+			},
+		},
+		PostCount: 2,
 		// this line is used by starport scaffolding # simapp/module/genesisState
 	}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&blogGenesis)
@@ -91,6 +174,204 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		blogsimulation.SimulateMsgDeletePost(am.accountKeeper, am.bankKeeper, am.keeper),
 	))
 
+	var weightMsgRestorePost int
+	simState.AppParams.GetOrGenerate(opWeightMsgRestorePost, &weightMsgRestorePost, nil,
+		func(_ *rand.Rand) {
+			weightMsgRestorePost = defaultWeightMsgRestorePost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRestorePost,
+		blogsimulation.SimulateMsgRestorePost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgCreateComment int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateComment, &weightMsgCreateComment, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateComment = defaultWeightMsgCreateComment
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateComment,
+		blogsimulation.SimulateMsgCreateComment(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUpdateComment int
+	simState.AppParams.GetOrGenerate(opWeightMsgUpdateComment, &weightMsgUpdateComment, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateComment = defaultWeightMsgUpdateComment
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateComment,
+		blogsimulation.SimulateMsgUpdateComment(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgDeleteComment int
+	simState.AppParams.GetOrGenerate(opWeightMsgDeleteComment, &weightMsgDeleteComment, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeleteComment = defaultWeightMsgDeleteComment
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDeleteComment,
+		blogsimulation.SimulateMsgDeleteComment(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgReplyComment int
+	simState.AppParams.GetOrGenerate(opWeightMsgReplyComment, &weightMsgReplyComment, nil,
+		func(_ *rand.Rand) {
+			weightMsgReplyComment = defaultWeightMsgReplyComment
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgReplyComment,
+		blogsimulation.SimulateMsgReplyComment(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgReactToPost int
+	simState.AppParams.GetOrGenerate(opWeightMsgReactToPost, &weightMsgReactToPost, nil,
+		func(_ *rand.Rand) {
+			weightMsgReactToPost = defaultWeightMsgReactToPost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgReactToPost,
+		blogsimulation.SimulateMsgReactToPost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRemoveReaction int
+	simState.AppParams.GetOrGenerate(opWeightMsgRemoveReaction, &weightMsgRemoveReaction, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoveReaction = defaultWeightMsgRemoveReaction
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRemoveReaction,
+		blogsimulation.SimulateMsgRemoveReaction(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgPublishPost int
+	simState.AppParams.GetOrGenerate(opWeightMsgPublishPost, &weightMsgPublishPost, nil,
+		func(_ *rand.Rand) {
+			weightMsgPublishPost = defaultWeightMsgPublishPost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPublishPost,
+		blogsimulation.SimulateMsgPublishPost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgTipPost int
+	simState.AppParams.GetOrGenerate(opWeightMsgTipPost, &weightMsgTipPost, nil,
+		func(_ *rand.Rand) {
+			weightMsgTipPost = defaultWeightMsgTipPost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTipPost,
+		blogsimulation.SimulateMsgTipPost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgTransferPost int
+	simState.AppParams.GetOrGenerate(opWeightMsgTransferPost, &weightMsgTransferPost, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferPost = defaultWeightMsgTransferPost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTransferPost,
+		blogsimulation.SimulateMsgTransferPost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAcceptPostTransfer int
+	simState.AppParams.GetOrGenerate(opWeightMsgAcceptPostTransfer, &weightMsgAcceptPostTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptPostTransfer = defaultWeightMsgAcceptPostTransfer
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptPostTransfer,
+		blogsimulation.SimulateMsgAcceptPostTransfer(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgMigrateAuthor int
+	simState.AppParams.GetOrGenerate(opWeightMsgMigrateAuthor, &weightMsgMigrateAuthor, nil,
+		func(_ *rand.Rand) {
+			weightMsgMigrateAuthor = defaultWeightMsgMigrateAuthor
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgMigrateAuthor,
+		blogsimulation.SimulateMsgMigrateAuthor(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgAddEditor int
+	simState.AppParams.GetOrGenerate(opWeightMsgAddEditor, &weightMsgAddEditor, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddEditor = defaultWeightMsgAddEditor
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAddEditor,
+		blogsimulation.SimulateMsgAddEditor(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgRemoveEditor int
+	simState.AppParams.GetOrGenerate(opWeightMsgRemoveEditor, &weightMsgRemoveEditor, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoveEditor = defaultWeightMsgRemoveEditor
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRemoveEditor,
+		blogsimulation.SimulateMsgRemoveEditor(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgHidePost int
+	simState.AppParams.GetOrGenerate(opWeightMsgHidePost, &weightMsgHidePost, nil,
+		func(_ *rand.Rand) {
+			weightMsgHidePost = defaultWeightMsgHidePost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgHidePost,
+		blogsimulation.SimulateMsgHidePost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgUnhidePost int
+	simState.AppParams.GetOrGenerate(opWeightMsgUnhidePost, &weightMsgUnhidePost, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnhidePost = defaultWeightMsgUnhidePost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUnhidePost,
+		blogsimulation.SimulateMsgUnhidePost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgReportPost int
+	simState.AppParams.GetOrGenerate(opWeightMsgReportPost, &weightMsgReportPost, nil,
+		func(_ *rand.Rand) {
+			weightMsgReportPost = defaultWeightMsgReportPost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgReportPost,
+		blogsimulation.SimulateMsgReportPost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	var weightMsgReviewPost int
+	simState.AppParams.GetOrGenerate(opWeightMsgReviewPost, &weightMsgReviewPost, nil,
+		func(_ *rand.Rand) {
+			weightMsgReviewPost = defaultWeightMsgReviewPost
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgReviewPost,
+		blogsimulation.SimulateMsgReviewPost(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
 	// this line is used by starport scaffolding # simapp/module/operation
 
 	return operations
@@ -123,6 +404,150 @@ func (am AppModule) ProposalMsgs(simState module.SimulationState) []simtypes.Wei
 				return nil
 			},
 		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgRestorePost,
+			defaultWeightMsgRestorePost,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgRestorePost(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgCreateComment,
+			defaultWeightMsgCreateComment,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgCreateComment(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgUpdateComment,
+			defaultWeightMsgUpdateComment,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgUpdateComment(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgDeleteComment,
+			defaultWeightMsgDeleteComment,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgDeleteComment(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgReplyComment,
+			defaultWeightMsgReplyComment,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgReplyComment(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgReactToPost,
+			defaultWeightMsgReactToPost,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgReactToPost(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgRemoveReaction,
+			defaultWeightMsgRemoveReaction,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgRemoveReaction(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgPublishPost,
+			defaultWeightMsgPublishPost,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgPublishPost(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgTipPost,
+			defaultWeightMsgTipPost,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgTipPost(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgTransferPost,
+			defaultWeightMsgTransferPost,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgTransferPost(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgAcceptPostTransfer,
+			defaultWeightMsgAcceptPostTransfer,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgAcceptPostTransfer(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgMigrateAuthor,
+			defaultWeightMsgMigrateAuthor,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgMigrateAuthor(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgAddEditor,
+			defaultWeightMsgAddEditor,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgAddEditor(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgRemoveEditor,
+			defaultWeightMsgRemoveEditor,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgRemoveEditor(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgHidePost,
+			defaultWeightMsgHidePost,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgHidePost(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgUnhidePost,
+			defaultWeightMsgUnhidePost,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgUnhidePost(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgReportPost,
+			defaultWeightMsgReportPost,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgReportPost(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
+		simulation.NewWeightedProposalMsg(
+			opWeightMsgReviewPost,
+			defaultWeightMsgReviewPost,
+			func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+				blogsimulation.SimulateMsgReviewPost(am.accountKeeper, am.bankKeeper, am.keeper)
+				return nil
+			},
+		),
 		// this line is used by starport scaffolding # simapp/module/OpMsg
 	}
 }
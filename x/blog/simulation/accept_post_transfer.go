@@ -0,0 +1,30 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func SimulateMsgAcceptPostTransfer(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgAcceptPostTransfer{
+			Creator: simAccount.Address.String(),
+		}
+
+		// TODO: Handling the AcceptPostTransfer simulation
+
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "AcceptPostTransfer simulation not implemented"), nil, nil
+	}
+}
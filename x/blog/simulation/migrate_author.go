@@ -0,0 +1,32 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"blog/x/blog/keeper"
+	"blog/x/blog/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+func SimulateMsgMigrateAuthor(
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		oldAccount, _ := simtypes.RandomAcc(r, accs)
+		newAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgMigrateAuthor{
+			OldAuthor: oldAccount.Address.String(),
+			NewAuthor: newAccount.Address.String(),
+		}
+
+		// TODO: Handling the MigrateAuthor simulation
+
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "MigrateAuthor simulation not implemented"), nil, nil
+	}
+}
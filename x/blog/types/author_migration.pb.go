@@ -0,0 +1,367 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blog/blog/author_migration.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AuthorMigration is an author key rotation whose posts are still being
+// moved from old_author to new_author in batches at the end of each block.
+type AuthorMigration struct {
+	OldAuthor string `protobuf:"bytes,1,opt,name=old_author,json=oldAuthor,proto3" json:"old_author,omitempty"`
+	NewAuthor string `protobuf:"bytes,2,opt,name=new_author,json=newAuthor,proto3" json:"new_author,omitempty"`
+}
+
+func (m *AuthorMigration) Reset()         { *m = AuthorMigration{} }
+func (m *AuthorMigration) String() string { return proto.CompactTextString(m) }
+func (*AuthorMigration) ProtoMessage()    {}
+func (*AuthorMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97bea4c393c290ba, []int{0}
+}
+func (m *AuthorMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuthorMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuthorMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuthorMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthorMigration.Merge(m, src)
+}
+func (m *AuthorMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuthorMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthorMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthorMigration proto.InternalMessageInfo
+
+func (m *AuthorMigration) GetOldAuthor() string {
+	if m != nil {
+		return m.OldAuthor
+	}
+	return ""
+}
+
+func (m *AuthorMigration) GetNewAuthor() string {
+	if m != nil {
+		return m.NewAuthor
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AuthorMigration)(nil), "blog.blog.AuthorMigration")
+}
+
+func init() { proto.RegisterFile("blog/blog/author_migration.proto", fileDescriptor_97bea4c393c290ba) }
+
+var fileDescriptor_97bea4c393c290ba = []byte{
+	// 151 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xca, 0xc9, 0x4f,
+	0xd7, 0x07, 0x13, 0x89, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0xf1, 0xb9, 0x99, 0xe9, 0x45, 0x89, 0x25,
+	0x99, 0xf9, 0x79, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x9c, 0x20, 0x49, 0x3d, 0x10, 0xa1,
+	0xe4, 0xcf, 0xc5, 0xef, 0x08, 0x56, 0xe4, 0x0b, 0x53, 0x23, 0x24, 0xcb, 0xc5, 0x95, 0x9f, 0x93,
+	0x12, 0x0f, 0xd1, 0x2b, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0xc4, 0x99, 0x9f, 0x93, 0x02, 0x51,
+	0x07, 0x92, 0xce, 0x4b, 0x2d, 0x87, 0x49, 0x33, 0x41, 0xa4, 0xf3, 0x52, 0xcb, 0x21, 0xd2, 0x4e,
+	0xda, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
+	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x08, 0x76, 0x52, 0x05,
+	0xc4, 0x65, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xf7, 0x18, 0x03, 0x06, 0x00, 0x98,
+	0xe9, 0xa3, 0x88, 0xb3, 0x00, 0x00, 0x00,
+}
+
+func (m *AuthorMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuthorMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuthorMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewAuthor) > 0 {
+		i -= len(m.NewAuthor)
+		copy(dAtA[i:], m.NewAuthor)
+		i = encodeVarintAuthorMigration(dAtA, i, uint64(len(m.NewAuthor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OldAuthor) > 0 {
+		i -= len(m.OldAuthor)
+		copy(dAtA[i:], m.OldAuthor)
+		i = encodeVarintAuthorMigration(dAtA, i, uint64(len(m.OldAuthor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthorMigration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthorMigration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuthorMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OldAuthor)
+	if l > 0 {
+		n += 1 + l + sovAuthorMigration(uint64(l))
+	}
+	l = len(m.NewAuthor)
+	if l > 0 {
+		n += 1 + l + sovAuthorMigration(uint64(l))
+	}
+	return n
+}
+
+func sovAuthorMigration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthorMigration(x uint64) (n int) {
+	return sovAuthorMigration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AuthorMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorMigration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuthorMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuthorMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorMigration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorMigration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorMigration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorMigration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorMigration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthorMigration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthorMigration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthorMigration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthorMigration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthorMigration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthorMigration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthorMigration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthorMigration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthorMigration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthorMigration = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,104 @@
+package types
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &PostAuthorization{}
+
+// NewPostAuthorization creates a new PostAuthorization for MsgCreatePost or
+// MsgUpdatePost.
+func NewPostAuthorization(msg sdk.Msg, maxPosts uint64, allowedTags []string, expiration *time.Time, postIDs []uint64) *PostAuthorization {
+	return &PostAuthorization{
+		MsgTypeUrl:  sdk.MsgTypeURL(msg),
+		MaxPosts:    maxPosts,
+		AllowedTags: allowedTags,
+		Expiration:  expiration,
+		PostIds:     postIDs,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a PostAuthorization) MsgTypeURL() string {
+	return a.MsgTypeUrl
+}
+
+// Accept implements Authorization.Accept.
+func (a PostAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	if sdk.MsgTypeURL(msg) != a.MsgTypeUrl {
+		return authz.AcceptResponse{}, errorsmod.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+	if a.Expiration != nil && !sdk.UnwrapSDKContext(ctx).BlockTime().Before(*a.Expiration) {
+		return authz.AcceptResponse{Accept: false, Delete: true}, nil
+	}
+
+	var tags []string
+	switch msg := msg.(type) {
+	case *MsgCreatePost:
+		tags = msg.Tags
+	case *MsgUpdatePost:
+		if len(a.PostIds) > 0 && !slices.Contains(a.PostIds, msg.Id) {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "post %d is not covered by the authorization", msg.Id)
+		}
+		tags = msg.Tags
+	default:
+		return authz.AcceptResponse{}, errorsmod.Wrap(sdkerrors.ErrInvalidType, "type mismatch")
+	}
+	if len(a.AllowedTags) > 0 {
+		normalized, err := NormalizeTags(tags)
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		for _, tag := range normalized {
+			if !slices.Contains(a.AllowedTags, tag) {
+				return authz.AcceptResponse{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "tag %q is not allowed by the authorization", tag)
+			}
+		}
+	}
+
+	switch a.MaxPosts {
+	case 0:
+		return authz.AcceptResponse{Accept: true}, nil
+	case 1:
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	updated := a
+	updated.MaxPosts--
+	return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a PostAuthorization) ValidateBasic() error {
+	switch a.MsgTypeUrl {
+	case sdk.MsgTypeURL(&MsgCreatePost{}):
+		if len(a.PostIds) > 0 {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "post ids only apply to MsgUpdatePost")
+		}
+	case sdk.MsgTypeURL(&MsgUpdatePost{}):
+	default:
+		return errorsmod.Wrapf(authz.ErrUnknownAuthorizationType, "cannot authorize %s", a.MsgTypeUrl)
+	}
+
+	seen := make(map[string]bool, len(a.AllowedTags))
+	for _, tag := range a.AllowedTags {
+		normalized, err := NormalizeTag(tag)
+		if err != nil {
+			return err
+		}
+		if normalized != tag {
+			return errorsmod.Wrapf(ErrInvalidTag, "allowed tag %q is not normalized", tag)
+		}
+		if seen[tag] {
+			return errorsmod.Wrapf(ErrInvalidTag, "duplicated allowed tag %q", tag)
+		}
+		seen[tag] = true
+	}
+	return nil
+}
@@ -0,0 +1,603 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blog/blog/authz.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PostAuthorization allows the grantee to create or update posts on behalf of
+// the granter, within limits.
+type PostAuthorization struct {
+	// msg_type_url is the message the authorization applies to, either
+	// MsgCreatePost or MsgUpdatePost.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// max_posts is the number of messages the grantee may still send. Zero
+	// means no limit.
+	MaxPosts uint64 `protobuf:"varint,2,opt,name=max_posts,json=maxPosts,proto3" json:"max_posts,omitempty"`
+	// allowed_tags restricts the tags the grantee may set. An empty list allows
+	// any tag.
+	AllowedTags []string `protobuf:"bytes,3,rep,name=allowed_tags,json=allowedTags,proto3" json:"allowed_tags,omitempty"`
+	// expiration is the time after which the authorization can no longer be
+	// used.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// post_ids restricts MsgUpdatePost to the given posts. An empty list allows
+	// any post.
+	PostIds []uint64 `protobuf:"varint,5,rep,packed,name=post_ids,json=postIds,proto3" json:"post_ids,omitempty"`
+}
+
+func (m *PostAuthorization) Reset()         { *m = PostAuthorization{} }
+func (m *PostAuthorization) String() string { return proto.CompactTextString(m) }
+func (*PostAuthorization) ProtoMessage()    {}
+func (*PostAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d670a853d94d3624, []int{0}
+}
+func (m *PostAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostAuthorization.Merge(m, src)
+}
+func (m *PostAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostAuthorization proto.InternalMessageInfo
+
+func (m *PostAuthorization) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *PostAuthorization) GetMaxPosts() uint64 {
+	if m != nil {
+		return m.MaxPosts
+	}
+	return 0
+}
+
+func (m *PostAuthorization) GetAllowedTags() []string {
+	if m != nil {
+		return m.AllowedTags
+	}
+	return nil
+}
+
+func (m *PostAuthorization) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *PostAuthorization) GetPostIds() []uint64 {
+	if m != nil {
+		return m.PostIds
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*PostAuthorization)(nil), "blog.blog.PostAuthorization")
+}
+
+func init() { proto.RegisterFile("blog/blog/authz.proto", fileDescriptor_d670a853d94d3624) }
+
+var fileDescriptor_d670a853d94d3624 = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x51, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0xad, 0xdb, 0xbc, 0xf7, 0x1a, 0xb7, 0x4b, 0xa3, 0xf7, 0x9e, 0xd2, 0x22, 0xa5, 0xa1, 0x53,
+	0x04, 0x22, 0x51, 0x61, 0x63, 0xa2, 0xdd, 0xd8, 0x50, 0x54, 0x16, 0x96, 0xc8, 0xa1, 0xc1, 0x8d,
+	0x14, 0xf7, 0x46, 0xb1, 0x03, 0x69, 0x3f, 0x81, 0xa9, 0x0b, 0xff, 0xc1, 0xc0, 0x47, 0x20, 0xa6,
+	0x8e, 0x6c, 0xa0, 0x76, 0xe0, 0x37, 0x50, 0xec, 0x54, 0x02, 0xb1, 0x1c, 0xf9, 0x9c, 0xeb, 0xeb,
+	0x7b, 0x7c, 0x2e, 0xfe, 0x17, 0x26, 0x40, 0x3d, 0x09, 0x24, 0x17, 0xb3, 0xa5, 0x9b, 0x66, 0x20,
+	0xc0, 0xd0, 0x4b, 0xc5, 0x2d, 0xa1, 0xd7, 0x21, 0x2c, 0x9e, 0x83, 0x27, 0x51, 0x55, 0x7b, 0xdd,
+	0x6b, 0xe0, 0x0c, 0x78, 0x20, 0x99, 0xa7, 0x48, 0x55, 0xfa, 0x4b, 0x81, 0x82, 0xd2, 0xcb, 0x53,
+	0xa5, 0xf6, 0x29, 0x00, 0x4d, 0x22, 0x4f, 0xb2, 0x30, 0xbf, 0xf1, 0x44, 0xcc, 0x22, 0x2e, 0x08,
+	0x4b, 0xd5, 0x85, 0xc1, 0x43, 0x1d, 0x77, 0x2e, 0x80, 0x8b, 0x51, 0x2e, 0x66, 0x90, 0xc5, 0x4b,
+	0x22, 0x62, 0x98, 0x1b, 0x36, 0x6e, 0x33, 0x4e, 0x03, 0xb1, 0x48, 0xa3, 0x20, 0xcf, 0x12, 0x13,
+	0xd9, 0xc8, 0xd1, 0x7d, 0xcc, 0x38, 0x9d, 0x2c, 0xd2, 0xe8, 0x32, 0x4b, 0x8c, 0x3d, 0xac, 0x33,
+	0x52, 0x04, 0x29, 0x70, 0xc1, 0xcd, 0xba, 0x8d, 0x1c, 0xcd, 0x6f, 0x32, 0x52, 0x94, 0x4f, 0x71,
+	0x63, 0x1f, 0xb7, 0x49, 0x92, 0xc0, 0x5d, 0x34, 0x0d, 0x04, 0xa1, 0xdc, 0x6c, 0xd8, 0x0d, 0x47,
+	0xf7, 0x5b, 0x95, 0x36, 0x21, 0x94, 0x1b, 0x67, 0x18, 0x47, 0x45, 0x1a, 0x67, 0x72, 0x9e, 0xa9,
+	0xd9, 0xc8, 0x69, 0x1d, 0xf7, 0x5c, 0xe5, 0xd6, 0xdd, 0xb9, 0x75, 0x27, 0x3b, 0xb7, 0x63, 0x6d,
+	0xf5, 0xd6, 0x47, 0xfe, 0x97, 0x1e, 0xa3, 0x8b, 0x9b, 0xe5, 0xf4, 0x20, 0x9e, 0x72, 0xf3, 0x97,
+	0xdd, 0x70, 0x34, 0xff, 0x4f, 0xc9, 0xcf, 0xa7, 0xfc, 0x74, 0xf4, 0xf2, 0x74, 0x34, 0xa8, 0xd2,
+	0x51, 0xe1, 0xde, 0x0e, 0xc3, 0x48, 0x90, 0xa1, 0xfb, 0xed, 0x9b, 0xf7, 0x1f, 0x8f, 0x07, 0xff,
+	0xe5, 0x06, 0x7e, 0x24, 0x30, 0x3e, 0x7c, 0xde, 0x58, 0x68, 0xbd, 0xb1, 0xd0, 0xfb, 0xc6, 0x42,
+	0xab, 0xad, 0x55, 0x5b, 0x6f, 0xad, 0xda, 0xeb, 0xd6, 0xaa, 0x5d, 0x75, 0x64, 0x47, 0xa1, 0x56,
+	0x57, 0x26, 0xc4, 0xc3, 0xdf, 0xd2, 0xf0, 0xc9, 0xe7, 0x00, 0x5b, 0xe0, 0xbb, 0x3d, 0xd4, 0x01,
+	0x00, 0x00,
+}
+
+func (m *PostAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PostIds) > 0 {
+		dAtA2 := make([]byte, len(m.PostIds)*10)
+		var j1 int
+		for _, num := range m.PostIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintAuthz(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Expiration != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintAuthz(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AllowedTags) > 0 {
+		for iNdEx := len(m.AllowedTags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedTags[iNdEx])
+			copy(dAtA[i:], m.AllowedTags[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedTags[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxPosts != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxPosts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PostAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.MaxPosts != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxPosts))
+	}
+	if len(m.AllowedTags) > 0 {
+		for _, s := range m.AllowedTags {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.PostIds) > 0 {
+		l = 0
+		for _, e := range m.PostIds {
+			l += sovAuthz(uint64(e))
+		}
+		n += 1 + sovAuthz(uint64(l)) + l
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PostAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PostAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PostAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPosts", wireType)
+			}
+			m.MaxPosts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPosts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedTags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedTags = append(m.AllowedTags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PostIds = append(m.PostIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAuthz
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAuthz
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PostIds) == 0 {
+					m.PostIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAuthz
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PostIds = append(m.PostIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,115 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"blog/testutil/sample"
+	"blog/x/blog/types"
+)
+
+func TestPostAuthorization_Accept(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.Context{}.WithBlockHeader(cmtproto.Header{Time: now})
+	creator := sample.AccAddress()
+	later := now.Add(time.Hour)
+
+	auth := types.NewPostAuthorization(&types.MsgCreatePost{}, 2, []string{"news", "tech"}, &later, nil)
+	require.NoError(t, auth.ValidateBasic())
+
+	_, err := auth.Accept(ctx, &types.MsgUpdatePost{Creator: creator})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+	_, err = auth.Accept(ctx, &types.MsgCreatePost{Creator: creator, Tags: []string{"sports"}})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	resp, err := auth.Accept(ctx, &types.MsgCreatePost{Creator: creator, Tags: []string{"News"}})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.False(t, resp.Delete)
+	updated, ok := resp.Updated.(*types.PostAuthorization)
+	require.True(t, ok)
+	require.Equal(t, uint64(1), updated.MaxPosts)
+
+	// the last allowed post removes the grant
+	resp, err = updated.Accept(ctx, &types.MsgCreatePost{Creator: creator})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	resp, err = auth.Accept(ctx.WithBlockTime(later), &types.MsgCreatePost{Creator: creator})
+	require.NoError(t, err)
+	require.False(t, resp.Accept)
+	require.True(t, resp.Delete)
+
+	// unlimited authorization restricted to some posts
+	auth = types.NewPostAuthorization(&types.MsgUpdatePost{}, 0, nil, nil, []uint64{3})
+	require.NoError(t, auth.ValidateBasic())
+	resp, err = auth.Accept(ctx, &types.MsgUpdatePost{Creator: creator, Id: 3, Tags: []string{"anything"}})
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+	require.Nil(t, resp.Updated)
+	_, err = auth.Accept(ctx, &types.MsgUpdatePost{Creator: creator, Id: 4})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestPostAuthorization_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		auth *types.PostAuthorization
+		err  error
+	}{
+		{
+			name: "unsupported message",
+			auth: types.NewPostAuthorization(&types.MsgDeletePost{}, 0, nil, nil, nil),
+			err:  authz.ErrUnknownAuthorizationType,
+		},
+		{
+			name: "post ids on create",
+			auth: types.NewPostAuthorization(&types.MsgCreatePost{}, 0, nil, nil, []uint64{1}),
+			err:  sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "tag not normalized",
+			auth: types.NewPostAuthorization(&types.MsgCreatePost{}, 0, []string{"News"}, nil, nil),
+			err:  types.ErrInvalidTag,
+		},
+		{
+			name: "duplicated tag",
+			auth: types.NewPostAuthorization(&types.MsgUpdatePost{}, 0, []string{"news", "news"}, nil, nil),
+			err:  types.ErrInvalidTag,
+		},
+		{
+			name: "valid",
+			auth: types.NewPostAuthorization(&types.MsgUpdatePost{}, 5, []string{"news"}, nil, []uint64{1, 2}),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.auth.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestPostAuthorization_Registered(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	authz.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+
+	grant, err := authz.NewGrant(time.Now(), types.NewPostAuthorization(&types.MsgCreatePost{}, 1, nil, nil, nil), nil)
+	require.NoError(t, err)
+	var auth authz.Authorization
+	require.NoError(t, registry.UnpackAny(grant.Authorization, &auth))
+	require.IsType(t, &types.PostAuthorization{}, auth)
+}
@@ -4,6 +4,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	// this line is used by starport scaffolding # 1
 )
 
@@ -17,10 +18,76 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgDeletePost{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRestorePost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateComment{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateComment{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDeleteComment{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgReplyComment{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgReactToPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRemoveReaction{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgPublishPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTipPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgTransferPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAcceptPostTransfer{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgMigrateAuthor{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAddEditor{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRemoveEditor{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgHidePost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUnhidePost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgReportPost{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgReviewPost{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateBlocklist{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateAnnouncement{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetFeaturedPosts{},
+	)
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&PostAuthorization{},
+	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// MaxCommentBodyBytes is the longest comment body in bytes.
+const MaxCommentBodyBytes = 4096
+
+// ValidateCommentBody returns an error if a comment body is blank or longer
+// than MaxCommentBodyBytes.
+func ValidateCommentBody(body string) error {
+	if strings.TrimSpace(body) == "" {
+		return errorsmod.Wrap(ErrInvalidComment, "comment body cannot be empty")
+	}
+	if len(body) > MaxCommentBodyBytes {
+		return errorsmod.Wrapf(ErrInvalidComment, "comment body is %d bytes, maximum is %d", len(body), MaxCommentBodyBytes)
+	}
+	return nil
+}
@@ -0,0 +1,1042 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blog/blog/comment.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Comment is a reply attached to a post.
+type Comment struct {
+	PostId  uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Id      uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	Body    string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// created_at is the block time at which the comment was created.
+	CreatedAt time.Time `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// created_height is the block height at which the comment was created.
+	CreatedHeight int64 `protobuf:"varint,6,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// updated_at is the block time of the latest edit.
+	UpdatedAt time.Time `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	// updated_height is the block height of the latest edit.
+	UpdatedHeight int64 `protobuf:"varint,8,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	// parent_id is the comment this one replies to. It is only meaningful when
+	// depth is greater than zero.
+	ParentId uint64 `protobuf:"varint,9,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// depth is the nesting level of the comment, zero for top-level comments.
+	Depth uint64 `protobuf:"varint,10,opt,name=depth,proto3" json:"depth,omitempty"`
+	// deleted marks a comment removed by its author while it still had
+	// replies. Its body is cleared and the node is kept so the replies stay
+	// attached to the thread.
+	Deleted bool `protobuf:"varint,11,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (m *Comment) Reset()         { *m = Comment{} }
+func (m *Comment) String() string { return proto.CompactTextString(m) }
+func (*Comment) ProtoMessage()    {}
+func (*Comment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320e89030312814f, []int{0}
+}
+func (m *Comment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Comment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Comment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Comment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Comment.Merge(m, src)
+}
+func (m *Comment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Comment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Comment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Comment proto.InternalMessageInfo
+
+func (m *Comment) GetPostId() uint64 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+func (m *Comment) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Comment) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Comment) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *Comment) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *Comment) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *Comment) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return time.Time{}
+}
+
+func (m *Comment) GetUpdatedHeight() int64 {
+	if m != nil {
+		return m.UpdatedHeight
+	}
+	return 0
+}
+
+func (m *Comment) GetParentId() uint64 {
+	if m != nil {
+		return m.ParentId
+	}
+	return 0
+}
+
+func (m *Comment) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *Comment) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+// CommentThreadNode is a comment together with a page of its replies.
+type CommentThreadNode struct {
+	Comment Comment             `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment"`
+	Replies []CommentThreadNode `protobuf:"bytes,2,rep,name=replies,proto3" json:"replies"`
+	// replies_next_key is the pagination key of the next page of direct
+	// replies, empty when every reply was returned.
+	RepliesNextKey []byte `protobuf:"bytes,3,opt,name=replies_next_key,json=repliesNextKey,proto3" json:"replies_next_key,omitempty"`
+}
+
+func (m *CommentThreadNode) Reset()         { *m = CommentThreadNode{} }
+func (m *CommentThreadNode) String() string { return proto.CompactTextString(m) }
+func (*CommentThreadNode) ProtoMessage()    {}
+func (*CommentThreadNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_320e89030312814f, []int{1}
+}
+func (m *CommentThreadNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommentThreadNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommentThreadNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommentThreadNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommentThreadNode.Merge(m, src)
+}
+func (m *CommentThreadNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommentThreadNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommentThreadNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommentThreadNode proto.InternalMessageInfo
+
+func (m *CommentThreadNode) GetComment() Comment {
+	if m != nil {
+		return m.Comment
+	}
+	return Comment{}
+}
+
+func (m *CommentThreadNode) GetReplies() []CommentThreadNode {
+	if m != nil {
+		return m.Replies
+	}
+	return nil
+}
+
+func (m *CommentThreadNode) GetRepliesNextKey() []byte {
+	if m != nil {
+		return m.RepliesNextKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Comment)(nil), "blog.blog.Comment")
+	proto.RegisterType((*CommentThreadNode)(nil), "blog.blog.CommentThreadNode")
+}
+
+func init() { proto.RegisterFile("blog/blog/comment.proto", fileDescriptor_320e89030312814f) }
+
+var fileDescriptor_320e89030312814f = []byte{
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xbd, 0x8a, 0xdb, 0x40,
+	0x10, 0xf6, 0xda, 0x3e, 0xcb, 0x1a, 0x27, 0x26, 0xb7, 0x1c, 0xdc, 0xe2, 0x04, 0x59, 0x1c, 0x04,
+	0x04, 0x01, 0x19, 0x9c, 0x36, 0x4d, 0x7c, 0x4d, 0x8e, 0xc0, 0x15, 0xe2, 0xaa, 0x34, 0x46, 0xba,
+	0x9d, 0x48, 0x22, 0x92, 0x77, 0x91, 0xf6, 0xc0, 0x7a, 0x80, 0xf4, 0xf7, 0x26, 0x79, 0x8d, 0x2b,
+	0x5d, 0xa6, 0x4a, 0x82, 0xfd, 0x22, 0x41, 0xfb, 0x93, 0x14, 0xa9, 0xd2, 0x2c, 0x33, 0xdf, 0xce,
+	0x37, 0xdf, 0xec, 0x7c, 0x0b, 0x97, 0x59, 0x25, 0xf2, 0x95, 0x3e, 0xee, 0x45, 0x5d, 0xe3, 0x4e,
+	0xc5, 0xb2, 0x11, 0x4a, 0x50, 0xbf, 0xc7, 0xe2, 0xfe, 0x58, 0x5c, 0xe4, 0x22, 0x17, 0x1a, 0x5d,
+	0xf5, 0x91, 0x29, 0x58, 0x2c, 0x73, 0x21, 0xf2, 0x0a, 0x57, 0x3a, 0xcb, 0x1e, 0x3e, 0xaf, 0x54,
+	0x59, 0x63, 0xab, 0xd2, 0x5a, 0x9a, 0x82, 0xab, 0xaf, 0x23, 0xf0, 0xae, 0x4d, 0x4f, 0x7a, 0x09,
+	0x9e, 0x14, 0xad, 0xda, 0x96, 0x9c, 0x91, 0x90, 0x44, 0xe3, 0x64, 0xd2, 0xa7, 0x37, 0x9c, 0xce,
+	0x61, 0x58, 0x72, 0x36, 0xd4, 0xd8, 0xb0, 0xe4, 0x94, 0x81, 0x77, 0xdf, 0x60, 0xaa, 0x44, 0xc3,
+	0x46, 0x21, 0x89, 0xfc, 0xc4, 0xa5, 0x94, 0xc2, 0x38, 0x13, 0xbc, 0x63, 0x63, 0x0d, 0xeb, 0x98,
+	0x5e, 0x03, 0xe8, 0x6b, 0xe4, 0xdb, 0x54, 0xb1, 0xb3, 0x90, 0x44, 0xb3, 0xf5, 0x22, 0x36, 0x83,
+	0xc5, 0x6e, 0xb0, 0xf8, 0xce, 0x0d, 0xb6, 0x99, 0x3e, 0xfd, 0x58, 0x0e, 0x1e, 0x7f, 0x2e, 0x49,
+	0xe2, 0x5b, 0xde, 0x7b, 0x45, 0x5f, 0xc3, 0xdc, 0x35, 0x29, 0xb0, 0xcc, 0x0b, 0xc5, 0x26, 0x21,
+	0x89, 0x46, 0xc9, 0x73, 0x8b, 0x7e, 0xd0, 0x60, 0xaf, 0xf5, 0x20, 0xb9, 0xd3, 0xf2, 0xfe, 0x47,
+	0xcb, 0xf2, 0x8c, 0x96, 0x6b, 0x62, 0xb5, 0xa6, 0x46, 0xcb, 0xa2, 0x56, 0xeb, 0x25, 0xf8, 0x32,
+	0x6d, 0x70, 0xa7, 0x17, 0xe6, 0xeb, 0xe5, 0x4c, 0x0d, 0x70, 0xc3, 0xe9, 0x05, 0x9c, 0x71, 0x94,
+	0xaa, 0x60, 0xa0, 0x2f, 0x4c, 0xd2, 0x2f, 0x8e, 0x63, 0x85, 0x0a, 0x39, 0x9b, 0x85, 0x24, 0x9a,
+	0x26, 0x2e, 0xbd, 0xfa, 0x46, 0xe0, 0xdc, 0xfa, 0x70, 0x57, 0x34, 0x98, 0xf2, 0x5b, 0xc1, 0x91,
+	0xae, 0xc1, 0xb3, 0x86, 0x6b, 0x47, 0x66, 0x6b, 0x1a, 0xff, 0x71, 0x3c, 0xb6, 0xe5, 0x9b, 0x71,
+	0xff, 0x86, 0xc4, 0x15, 0xd2, 0x77, 0xe0, 0x35, 0x28, 0xab, 0x12, 0x5b, 0x36, 0x0c, 0x47, 0xd1,
+	0x6c, 0xfd, 0xea, 0x5f, 0xce, 0x5f, 0x09, 0xc7, 0xb6, 0x14, 0x1a, 0xc1, 0x0b, 0x1b, 0x6e, 0x77,
+	0xb8, 0x57, 0xdb, 0x2f, 0xd8, 0x69, 0x8f, 0x9f, 0x25, 0x73, 0x8b, 0xdf, 0xe2, 0x5e, 0x7d, 0xc4,
+	0x6e, 0xf3, 0xe6, 0xe9, 0x18, 0x90, 0xc3, 0x31, 0x20, 0xbf, 0x8e, 0x01, 0x79, 0x3c, 0x05, 0x83,
+	0xc3, 0x29, 0x18, 0x7c, 0x3f, 0x05, 0x83, 0x4f, 0xe7, 0xfa, 0xa7, 0xee, 0xcd, 0x87, 0x55, 0x9d,
+	0xc4, 0x36, 0x9b, 0xe8, 0xdd, 0xbf, 0xfd, 0x3d, 0x00, 0x8a, 0xb9, 0x0e, 0xc2, 0xca, 0x02, 0x00,
+	0x00,
+}
+
+func (m *Comment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Comment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Comment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Depth != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ParentId != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.ParentId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintComment(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.CreatedHeight != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintComment(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PostId != 0 {
+		i = encodeVarintComment(dAtA, i, uint64(m.PostId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommentThreadNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommentThreadNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommentThreadNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RepliesNextKey) > 0 {
+		i -= len(m.RepliesNextKey)
+		copy(dAtA[i:], m.RepliesNextKey)
+		i = encodeVarintComment(dAtA, i, uint64(len(m.RepliesNextKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Replies) > 0 {
+		for iNdEx := len(m.Replies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Replies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintComment(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Comment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintComment(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintComment(dAtA []byte, offset int, v uint64) int {
+	offset -= sovComment(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Comment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostId != 0 {
+		n += 1 + sovComment(uint64(m.PostId))
+	}
+	if m.Id != 0 {
+		n += 1 + sovComment(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovComment(uint64(l))
+	if m.CreatedHeight != 0 {
+		n += 1 + sovComment(uint64(m.CreatedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovComment(uint64(l))
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovComment(uint64(m.UpdatedHeight))
+	}
+	if m.ParentId != 0 {
+		n += 1 + sovComment(uint64(m.ParentId))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovComment(uint64(m.Depth))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	return n
+}
+
+func (m *CommentThreadNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Comment.Size()
+	n += 1 + l + sovComment(uint64(l))
+	if len(m.Replies) > 0 {
+		for _, e := range m.Replies {
+			l = e.Size()
+			n += 1 + l + sovComment(uint64(l))
+		}
+	}
+	l = len(m.RepliesNextKey)
+	if l > 0 {
+		n += 1 + l + sovComment(uint64(l))
+	}
+	return n
+}
+
+func sovComment(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozComment(x uint64) (n int) {
+	return sovComment(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Comment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowComment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Comment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Comment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			m.PostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			m.ParentId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParentId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipComment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthComment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommentThreadNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowComment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommentThreadNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommentThreadNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Comment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Replies = append(m.Replies, CommentThreadNode{})
+			if err := m.Replies[len(m.Replies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepliesNextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthComment
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthComment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepliesNextKey = append(m.RepliesNextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.RepliesNextKey == nil {
+				m.RepliesNextKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipComment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthComment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipComment(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowComment
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowComment
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthComment
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupComment
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthComment
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthComment        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowComment          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupComment = fmt.Errorf("proto: unexpected end of group")
+)
@@ -8,6 +8,34 @@ import (
 
 // x/blog module sentinel errors
 var (
-	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidSigner        = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrSample               = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrPostDeleted          = sdkerrors.Register(ModuleName, 1102, "post is deleted")
+	ErrPostNotDeleted       = sdkerrors.Register(ModuleName, 1103, "post is not deleted")
+	ErrRestoreWindowExpired = sdkerrors.Register(ModuleName, 1104, "restore window has expired")
+	ErrMaxCommentDepth      = sdkerrors.Register(ModuleName, 1105, "maximum comment depth exceeded")
+	ErrInvalidTag           = sdkerrors.Register(ModuleName, 1106, "invalid tag")
+	ErrTooManyTags          = sdkerrors.Register(ModuleName, 1107, "too many tags")
+	ErrTagTooLong           = sdkerrors.Register(ModuleName, 1108, "tag too long")
+	ErrInvalidReactionKind  = sdkerrors.Register(ModuleName, 1109, "invalid reaction kind")
+	ErrAlreadyReacted       = sdkerrors.Register(ModuleName, 1110, "reaction already exists")
+	ErrInvalidPostStatus    = sdkerrors.Register(ModuleName, 1111, "invalid post status")
+	ErrInvalidPublishTime   = sdkerrors.Register(ModuleName, 1112, "invalid publish time")
+	ErrPostPublished        = sdkerrors.Register(ModuleName, 1113, "post is already published")
+	ErrTitleTooLong         = sdkerrors.Register(ModuleName, 1114, "post title too long")
+	ErrBodyTooLong          = sdkerrors.Register(ModuleName, 1115, "post body too long")
+	ErrNoTransferOffer      = sdkerrors.Register(ModuleName, 1116, "no transfer offer")
+	ErrAuthorMigration      = sdkerrors.Register(ModuleName, 1117, "author migration already in progress")
+	ErrEditorExists         = sdkerrors.Register(ModuleName, 1118, "account is already an editor")
+	ErrEditorNotFound       = sdkerrors.Register(ModuleName, 1119, "account is not an editor")
+	ErrInvalidHideReason    = sdkerrors.Register(ModuleName, 1120, "invalid hide reason")
+	ErrPostHidden           = sdkerrors.Register(ModuleName, 1121, "post is hidden")
+	ErrPostNotHidden        = sdkerrors.Register(ModuleName, 1122, "post is not hidden")
+	ErrAlreadyReported      = sdkerrors.Register(ModuleName, 1123, "post already reported")
+	ErrPostNotInReview      = sdkerrors.Register(ModuleName, 1124, "post is not awaiting review")
+	ErrAddressBlocked       = sdkerrors.Register(ModuleName, 1125, "address is blocked")
+	ErrInvalidExpiry        = sdkerrors.Register(ModuleName, 1126, "invalid expiry")
+	ErrInvalidComment       = sdkerrors.Register(ModuleName, 1127, "invalid comment")
+	ErrCommentDeleted       = sdkerrors.Register(ModuleName, 1128, "comment is deleted")
+	ErrOfficialPost         = sdkerrors.Register(ModuleName, 1129, "post is official")
 )
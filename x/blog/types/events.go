@@ -0,0 +1,11 @@
+package types
+
+// blog module event types
+const (
+	EventTypeTipPost = "tip_post"
+
+	AttributeKeyPostID    = "post_id"
+	AttributeKeySender    = "sender"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyAmount    = "amount"
+)
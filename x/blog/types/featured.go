@@ -0,0 +1,19 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ValidateFeaturedPosts returns an error if a post is featured more than
+// once.
+func ValidateFeaturedPosts(featured []FeaturedPost) error {
+	seen := make(map[uint64]bool, len(featured))
+	for _, elem := range featured {
+		if seen[elem.PostId] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "post %d is featured more than once", elem.PostId)
+		}
+		seen[elem.PostId] = true
+	}
+	return nil
+}
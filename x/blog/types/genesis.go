@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -8,6 +14,16 @@ const DefaultIndex uint64 = 1
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
+		PostList:            []Post{},
+		PostRevisionList:    []PostRevision{},
+		CommentList:         []Comment{},
+		ReactionList:        []Reaction{},
+		AuthorTipsList:      []AuthorTips{},
+		AuthorMigrationList: []AuthorMigration{},
+		ReportList:          []Report{},
+		ReviewQueue:         []uint64{},
+		Blocklist:           []string{},
+		FeaturedPostList:    []FeaturedPost{},
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -16,6 +32,179 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	// Check for duplicated ID in post
+	postIdMap := make(map[uint64]Post)
+	postCount := gs.GetPostCount()
+	for _, elem := range gs.PostList {
+		if _, ok := postIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for post")
+		}
+		if elem.Id >= postCount {
+			return fmt.Errorf("post id should be lower than the post count")
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Creator); err != nil {
+			return fmt.Errorf("invalid creator of post %d: %w", elem.Id, err)
+		}
+		if elem.OriginalAuthor != "" {
+			if _, err := sdk.AccAddressFromBech32(elem.OriginalAuthor); err != nil {
+				return fmt.Errorf("invalid original author of post %d: %w", elem.Id, err)
+			}
+		}
+		editorMap := make(map[string]bool)
+		for _, editor := range elem.Editors {
+			if _, err := sdk.AccAddressFromBech32(editor); err != nil {
+				return fmt.Errorf("invalid editor %s on post %d: %w", editor, elem.Id, err)
+			}
+			if editorMap[editor] {
+				return fmt.Errorf("duplicated editor %s on post %d", editor, elem.Id)
+			}
+			editorMap[editor] = true
+		}
+		postIdMap[elem.Id] = elem
+	}
+
+	// Check that every post revision belongs to an existing post and is unique
+	postRevisionMap := make(map[uint64]map[uint64]bool)
+	for _, elem := range gs.PostRevisionList {
+		post, ok := postIdMap[elem.PostId]
+		if !ok {
+			return fmt.Errorf("revision %d refers to unknown post %d", elem.Revision, elem.PostId)
+		}
+		if elem.Revision >= post.Revision {
+			return fmt.Errorf("revision %d of post %d should be lower than the current revision", elem.Revision, elem.PostId)
+		}
+		if postRevisionMap[elem.PostId] == nil {
+			postRevisionMap[elem.PostId] = make(map[uint64]bool)
+		}
+		if postRevisionMap[elem.PostId][elem.Revision] {
+			return fmt.Errorf("duplicated revision %d for post %d", elem.Revision, elem.PostId)
+		}
+		postRevisionMap[elem.PostId][elem.Revision] = true
+	}
+	// Check for duplicated ID in comment
+	commentIdMap := make(map[uint64]Comment)
+	commentCount := gs.GetCommentCount()
+	for _, elem := range gs.CommentList {
+		if _, ok := commentIdMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for comment")
+		}
+		if elem.Id >= commentCount {
+			return fmt.Errorf("comment id should be lower than the comment count")
+		}
+		if _, ok := postIdMap[elem.PostId]; !ok {
+			return fmt.Errorf("comment %d refers to unknown post %d", elem.Id, elem.PostId)
+		}
+		commentIdMap[elem.Id] = elem
+	}
+
+	// Check that every reply hangs off an existing comment of the same post
+	for _, elem := range gs.CommentList {
+		if elem.Depth == 0 {
+			continue
+		}
+		parent, ok := commentIdMap[elem.ParentId]
+		if !ok || parent.PostId != elem.PostId {
+			return fmt.Errorf("comment %d replies to unknown comment %d", elem.Id, elem.ParentId)
+		}
+		if elem.Depth != parent.Depth+1 {
+			return fmt.Errorf("comment %d should have depth %d", elem.Id, parent.Depth+1)
+		}
+	}
+
+	// Check that every reaction is valid, unique and left on an existing post
+	reactionMap := make(map[string]bool)
+	for _, elem := range gs.ReactionList {
+		if _, ok := postIdMap[elem.PostId]; !ok {
+			return fmt.Errorf("reaction of %s refers to unknown post %d", elem.Creator, elem.PostId)
+		}
+		if err := ValidateReactionKind(elem.Kind); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%d/%s/%d", elem.PostId, elem.Creator, elem.Kind)
+		if reactionMap[key] {
+			return fmt.Errorf("duplicated %s reaction of %s on post %d", elem.Kind, elem.Creator, elem.PostId)
+		}
+		reactionMap[key] = true
+	}
+
+	// Check that author tips are valid and unique per author
+	authorTipsMap := make(map[string]bool)
+	for _, elem := range gs.AuthorTipsList {
+		if authorTipsMap[elem.Author] {
+			return fmt.Errorf("duplicated tips for author %s", elem.Author)
+		}
+		if err := elem.Tips.Validate(); err != nil {
+			return fmt.Errorf("invalid tips for author %s: %w", elem.Author, err)
+		}
+		authorTipsMap[elem.Author] = true
+	}
+
+	// Check that at most one migration is in progress per old author
+	authorMigrationMap := make(map[string]bool)
+	for _, elem := range gs.AuthorMigrationList {
+		if authorMigrationMap[elem.OldAuthor] {
+			return fmt.Errorf("duplicated migration for author %s", elem.OldAuthor)
+		}
+		if elem.OldAuthor == elem.NewAuthor {
+			return fmt.Errorf("author %s is migrated to itself", elem.OldAuthor)
+		}
+		authorMigrationMap[elem.OldAuthor] = true
+	}
+
+	// Check that every report is valid, unique per reporter and filed against
+	// an existing post
+	reportMap := make(map[string]bool)
+	for _, elem := range gs.ReportList {
+		if _, ok := postIdMap[elem.PostId]; !ok {
+			return fmt.Errorf("report of %s refers to unknown post %d", elem.Reporter, elem.PostId)
+		}
+		if err := ValidateHideReason(elem.Reason); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%d/%s", elem.PostId, elem.Reporter)
+		if reportMap[key] {
+			return fmt.Errorf("duplicated report of %s on post %d", elem.Reporter, elem.PostId)
+		}
+		reportMap[key] = true
+	}
+
+	// Check that the review queue only holds existing hidden posts, once
+	reviewQueueMap := make(map[uint64]bool)
+	for _, id := range gs.ReviewQueue {
+		post, ok := postIdMap[id]
+		if !ok {
+			return fmt.Errorf("review queue refers to unknown post %d", id)
+		}
+		if !post.Hidden {
+			return fmt.Errorf("post %d in the review queue is not hidden", id)
+		}
+		if reviewQueueMap[id] {
+			return fmt.Errorf("duplicated post %d in the review queue", id)
+		}
+		reviewQueueMap[id] = true
+	}
+
+	// Check that the blocklist holds valid addresses, once
+	blocklistMap := make(map[string]bool)
+	for _, address := range gs.Blocklist {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid blocked address %s: %w", address, err)
+		}
+		if blocklistMap[address] {
+			return fmt.Errorf("duplicated blocked address %s", address)
+		}
+		blocklistMap[address] = true
+	}
+
+	// Check that every featured post exists and is featured once
+	if err := ValidateFeaturedPosts(gs.FeaturedPostList); err != nil {
+		return err
+	}
+	for _, elem := range gs.FeaturedPostList {
+		if _, ok := postIdMap[elem.PostId]; !ok {
+			return fmt.Errorf("featured post %d is unknown", elem.PostId)
+		}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -28,6 +28,30 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// postList holds every post stored by the module.
+	PostList []Post `protobuf:"bytes,2,rep,name=postList,proto3" json:"postList"`
+	// postCount is the ID that will be assigned to the next post.
+	PostCount uint64 `protobuf:"varint,3,opt,name=postCount,proto3" json:"postCount,omitempty"`
+	// postRevisionList holds the saved previous versions of every post.
+	PostRevisionList []PostRevision `protobuf:"bytes,4,rep,name=postRevisionList,proto3" json:"postRevisionList"`
+	// commentList holds every comment stored by the module.
+	CommentList []Comment `protobuf:"bytes,5,rep,name=commentList,proto3" json:"commentList"`
+	// commentCount is the ID that will be assigned to the next comment.
+	CommentCount uint64 `protobuf:"varint,6,opt,name=commentCount,proto3" json:"commentCount,omitempty"`
+	// reactionList holds every reaction left on a post.
+	ReactionList []Reaction `protobuf:"bytes,7,rep,name=reactionList,proto3" json:"reactionList"`
+	// authorTipsList holds the cumulative tips received by every author.
+	AuthorTipsList []AuthorTips `protobuf:"bytes,8,rep,name=authorTipsList,proto3" json:"authorTipsList"`
+	// authorMigrationList holds the author migrations still in progress.
+	AuthorMigrationList []AuthorMigration `protobuf:"bytes,9,rep,name=authorMigrationList,proto3" json:"authorMigrationList"`
+	// reportList holds every report filed against a post.
+	ReportList []Report `protobuf:"bytes,10,rep,name=reportList,proto3" json:"reportList"`
+	// reviewQueue holds the IDs of the posts hidden by reports and awaiting review.
+	ReviewQueue []uint64 `protobuf:"varint,11,rep,packed,name=reviewQueue,proto3" json:"reviewQueue,omitempty"`
+	// blocklist holds the addresses blocked from posting and interacting.
+	Blocklist []string `protobuf:"bytes,12,rep,name=blocklist,proto3" json:"blocklist,omitempty"`
+	// featuredPostList holds the posts featured by governance, in order.
+	FeaturedPostList []FeaturedPost `protobuf:"bytes,13,rep,name=featuredPostList,proto3" json:"featuredPostList"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +94,90 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPostList() []Post {
+	if m != nil {
+		return m.PostList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPostCount() uint64 {
+	if m != nil {
+		return m.PostCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPostRevisionList() []PostRevision {
+	if m != nil {
+		return m.PostRevisionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommentList() []Comment {
+	if m != nil {
+		return m.CommentList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommentCount() uint64 {
+	if m != nil {
+		return m.CommentCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetReactionList() []Reaction {
+	if m != nil {
+		return m.ReactionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthorTipsList() []AuthorTips {
+	if m != nil {
+		return m.AuthorTipsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthorMigrationList() []AuthorMigration {
+	if m != nil {
+		return m.AuthorMigrationList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReportList() []Report {
+	if m != nil {
+		return m.ReportList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReviewQueue() []uint64 {
+	if m != nil {
+		return m.ReviewQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlocklist() []string {
+	if m != nil {
+		return m.Blocklist
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeaturedPostList() []FeaturedPost {
+	if m != nil {
+		return m.FeaturedPostList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "blog.blog.GenesisState")
 }
@@ -77,19 +185,39 @@ func init() {
 func init() { proto.RegisterFile("blog/blog/genesis.proto", fileDescriptor_8ec1b9f8d5f8f516) }
 
 var fileDescriptor_8ec1b9f8d5f8f516 = []byte{
-	// 179 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0xca, 0xc9, 0x4f,
-	0xd7, 0x07, 0x13, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x9c, 0x20, 0x31, 0x3d, 0x10, 0x21, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26,
-	0x21, 0xb2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x15, 0x43,
-	0x18, 0x56, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x35, 0x4b, 0xc9, 0x85, 0x8b, 0xc7, 0x1d, 0x62, 0x78,
-	0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x09, 0x17, 0x1b, 0x44, 0x5e, 0x82, 0x51, 0x81, 0x51, 0x83,
-	0xdb, 0x48, 0x50, 0x0f, 0x6e, 0x99, 0x5e, 0x00, 0x58, 0xc2, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86,
-	0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x41, 0xd5, 0x3a, 0x69, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x20, 0xd8, 0xca, 0x0a, 0x88, 0xcd, 0x25, 0x95, 0x05, 0xa9, 0xc5,
-	0x49, 0x6c, 0x60, 0x9b, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa1, 0x53, 0x6f, 0x38, 0xe0,
-	0x00, 0x00, 0x00,
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x1b, 0xd3, 0xad, 0x9b, 0x49, 0xfd, 0xd3, 0xa9, 0xee, 0x86, 0x22, 0x31, 0xec, 0x29,
+	0x28, 0xb4, 0xb8, 0x0a, 0x82, 0xe0, 0xc1, 0x16, 0x14, 0x41, 0x61, 0x1d, 0x3d, 0x79, 0x91, 0xb4,
+	0x8e, 0x71, 0xb0, 0xc9, 0x84, 0xcc, 0x64, 0xd5, 0x6f, 0xe1, 0xc7, 0xf0, 0xe8, 0xc7, 0xd8, 0xe3,
+	0x1e, 0x3d, 0x88, 0x48, 0x7b, 0xf0, 0x6b, 0x2c, 0xf3, 0xce, 0xa4, 0x99, 0x4d, 0x7b, 0x09, 0x93,
+	0xe7, 0x79, 0xde, 0xdf, 0x9b, 0xcc, 0x3b, 0x83, 0x0e, 0xe7, 0x4b, 0x9e, 0x4e, 0xe0, 0x91, 0xd2,
+	0x9c, 0x0a, 0x26, 0xc6, 0x45, 0xc9, 0x25, 0xc7, 0x9e, 0xd2, 0xc6, 0xea, 0x31, 0x1a, 0x24, 0x19,
+	0xcb, 0xf9, 0x04, 0x9e, 0xda, 0x1d, 0xdd, 0x4a, 0x79, 0xca, 0x61, 0x39, 0x51, 0x2b, 0xa3, 0x1e,
+	0x34, 0xb0, 0x22, 0x29, 0x93, 0x4c, 0xd4, 0x69, 0x4b, 0xe7, 0x42, 0x1a, 0xd5, 0x6a, 0xbd, 0xe0,
+	0x59, 0x46, 0xf3, 0xda, 0x08, 0x1a, 0xa3, 0xa4, 0xc9, 0x42, 0x32, 0x9e, 0x1b, 0x67, 0xd8, 0x38,
+	0x92, 0x15, 0x46, 0x8c, 0x1a, 0x31, 0xa9, 0xe4, 0x67, 0x5e, 0x7e, 0xc8, 0x58, 0x5a, 0x26, 0x56,
+	0xd9, 0x81, 0x0d, 0x2c, 0x78, 0xb9, 0xa3, 0xd1, 0x27, 0x9a, 0xc8, 0xaa, 0xa4, 0x1f, 0xb5, 0x73,
+	0xf4, 0x67, 0x0f, 0xf5, 0x5f, 0xe8, 0xfd, 0x78, 0x2b, 0x13, 0x49, 0xf1, 0x23, 0xd4, 0xd3, 0xbf,
+	0x14, 0x38, 0x91, 0x13, 0xfb, 0xc7, 0x83, 0xf1, 0x66, 0x7f, 0xc6, 0x27, 0x60, 0x4c, 0xbd, 0xb3,
+	0xbf, 0x77, 0x3b, 0x3f, 0xff, 0xff, 0xba, 0xe7, 0x10, 0x93, 0xc5, 0x0f, 0xd0, 0xbe, 0xfa, 0xe1,
+	0x57, 0x4c, 0xc8, 0xe0, 0x4a, 0xe4, 0xc6, 0xfe, 0xf1, 0x0d, 0xbb, 0x8e, 0x0b, 0x39, 0xed, 0xaa,
+	0x2a, 0xb2, 0x89, 0xe1, 0x3b, 0xc8, 0x53, 0xeb, 0x19, 0xaf, 0x72, 0x19, 0xb8, 0x91, 0x13, 0x77,
+	0x49, 0x23, 0xe0, 0x97, 0xe8, 0xa6, 0x7a, 0x21, 0xf4, 0x94, 0x09, 0xc6, 0x73, 0x00, 0x77, 0x01,
+	0x7c, 0xd8, 0x02, 0xd7, 0x11, 0xd3, 0x60, 0xab, 0x0c, 0x3f, 0x41, 0xbe, 0xd9, 0x76, 0xa0, 0xec,
+	0x01, 0x05, 0x5b, 0x94, 0x99, 0x76, 0x0d, 0xc0, 0x0e, 0xe3, 0x23, 0xd4, 0x37, 0xaf, 0xfa, 0x3b,
+	0x7b, 0xf0, 0x9d, 0x97, 0x34, 0xfc, 0x14, 0xf5, 0xeb, 0xe9, 0x41, 0x83, 0xab, 0xd0, 0x60, 0x68,
+	0x35, 0x20, 0xc6, 0x36, 0x1d, 0x2e, 0xc5, 0xf1, 0x0c, 0x5d, 0xd7, 0xd3, 0x7c, 0xc7, 0x0a, 0x01,
+	0x80, 0x7d, 0x00, 0xdc, 0xb6, 0x00, 0xcf, 0x36, 0x01, 0x83, 0x68, 0x95, 0x60, 0x82, 0x86, 0x5a,
+	0x79, 0x5d, 0x9f, 0x08, 0x20, 0x79, 0x40, 0x1a, 0x6d, 0x91, 0x36, 0x29, 0x83, 0xdb, 0x55, 0x8c,
+	0x1f, 0x23, 0xa4, 0x0f, 0x11, 0xa0, 0x50, 0xe4, 0xb6, 0x4e, 0x03, 0x01, 0xd3, 0x10, 0xac, 0x28,
+	0x8e, 0x90, 0x5f, 0xd2, 0x53, 0x46, 0xbf, 0xbe, 0xa9, 0x68, 0x45, 0x03, 0x3f, 0x72, 0xe3, 0x2e,
+	0xb1, 0x25, 0x35, 0xfb, 0xf9, 0x92, 0x2f, 0xbe, 0x2c, 0x15, 0xb9, 0x1f, 0xb9, 0xb1, 0x47, 0x1a,
+	0x41, 0xcd, 0xbe, 0x3e, 0xa5, 0x27, 0xf5, 0xa1, 0xba, 0xb6, 0x35, 0xfb, 0xe7, 0x56, 0xa4, 0x9e,
+	0x7d, 0xbb, 0x6c, 0x7a, 0xff, 0x6c, 0x15, 0x3a, 0xe7, 0xab, 0xd0, 0xf9, 0xb7, 0x0a, 0x9d, 0x1f,
+	0xeb, 0xb0, 0x73, 0xbe, 0x0e, 0x3b, 0xbf, 0xd7, 0x61, 0xe7, 0xfd, 0x00, 0x6e, 0xc3, 0x37, 0x73,
+	0xc7, 0xbe, 0x17, 0x54, 0xcc, 0x7b, 0x70, 0x25, 0x1e, 0x5e, 0x0c, 0x00, 0xa2, 0x10, 0xef, 0xe3,
+	0x2b, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +240,155 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeaturedPostList) > 0 {
+		for iNdEx := len(m.FeaturedPostList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeaturedPostList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Blocklist) > 0 {
+		for iNdEx := len(m.Blocklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Blocklist[iNdEx])
+			copy(dAtA[i:], m.Blocklist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Blocklist[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ReviewQueue) > 0 {
+		dAtA2 := make([]byte, len(m.ReviewQueue)*10)
+		var j1 int
+		for _, num := range m.ReviewQueue {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.ReportList) > 0 {
+		for iNdEx := len(m.ReportList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReportList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AuthorMigrationList) > 0 {
+		for iNdEx := len(m.AuthorMigrationList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthorMigrationList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AuthorTipsList) > 0 {
+		for iNdEx := len(m.AuthorTipsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuthorTipsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ReactionList) > 0 {
+		for iNdEx := len(m.ReactionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReactionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.CommentCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CommentCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CommentList) > 0 {
+		for iNdEx := len(m.CommentList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommentList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PostRevisionList) > 0 {
+		for iNdEx := len(m.PostRevisionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostRevisionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.PostCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PostCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PostList) > 0 {
+		for iNdEx := len(m.PostList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PostList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +421,73 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PostList) > 0 {
+		for _, e := range m.PostList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PostCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PostCount))
+	}
+	if len(m.PostRevisionList) > 0 {
+		for _, e := range m.PostRevisionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CommentList) > 0 {
+		for _, e := range m.CommentList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CommentCount != 0 {
+		n += 1 + sovGenesis(uint64(m.CommentCount))
+	}
+	if len(m.ReactionList) > 0 {
+		for _, e := range m.ReactionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuthorTipsList) > 0 {
+		for _, e := range m.AuthorTipsList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuthorMigrationList) > 0 {
+		for _, e := range m.AuthorMigrationList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReportList) > 0 {
+		for _, e := range m.ReportList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReviewQueue) > 0 {
+		l = 0
+		for _, e := range m.ReviewQueue {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.Blocklist) > 0 {
+		for _, s := range m.Blocklist {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeaturedPostList) > 0 {
+		for _, e := range m.FeaturedPostList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -215,6 +559,424 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostList = append(m.PostList, Post{})
+			if err := m.PostList[len(m.PostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostCount", wireType)
+			}
+			m.PostCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostRevisionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PostRevisionList = append(m.PostRevisionList, PostRevision{})
+			if err := m.PostRevisionList[len(m.PostRevisionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommentList = append(m.CommentList, Comment{})
+			if err := m.CommentList[len(m.CommentList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentCount", wireType)
+			}
+			m.CommentCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommentCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReactionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReactionList = append(m.ReactionList, Reaction{})
+			if err := m.ReactionList[len(m.ReactionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorTipsList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorTipsList = append(m.AuthorTipsList, AuthorTips{})
+			if err := m.AuthorTipsList[len(m.AuthorTipsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorMigrationList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorMigrationList = append(m.AuthorMigrationList, AuthorMigration{})
+			if err := m.AuthorMigrationList[len(m.AuthorMigrationList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportList = append(m.ReportList, Report{})
+			if err := m.ReportList[len(m.ReportList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ReviewQueue = append(m.ReviewQueue, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ReviewQueue) == 0 {
+					m.ReviewQueue = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ReviewQueue = append(m.ReviewQueue, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewQueue", wireType)
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blocklist = append(m.Blocklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeaturedPostList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeaturedPostList = append(m.FeaturedPostList, FeaturedPost{})
+			if err := m.FeaturedPostList[len(m.FeaturedPostList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
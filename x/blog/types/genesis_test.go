@@ -3,12 +3,15 @@ package types_test
 import (
 	"testing"
 
+	"blog/testutil/sample"
 	"blog/x/blog/types"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	creator := sample.AccAddress()
+	blocked := sample.AccAddress()
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -20,13 +23,465 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc: "valid genesis state",
 			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
 
+				PostList: []types.Post{
+					{
+						Id:       0,
+						Creator:  creator,
+						Revision: 1,
+					},
+					{
+						Id:      1,
+						Creator: creator,
+						Hidden:  true,
+					},
+				},
+				PostCount: 2,
+				PostRevisionList: []types.PostRevision{
+					{
+						PostId:   0,
+						Revision: 0,
+					},
+				},
+				CommentList: []types.Comment{
+					{
+						PostId: 0,
+						Id:     0,
+					},
+					{
+						PostId: 1,
+						Id:     1,
+					},
+				},
+				CommentCount: 2,
+				ReactionList: []types.Reaction{
+					{
+						PostId:  0,
+						Creator: "alice",
+						Kind:    types.ReactionKind_REACTION_KIND_LIKE,
+					},
+					{
+						PostId:  0,
+						Creator: "alice",
+						Kind:    types.ReactionKind_REACTION_KIND_LOVE,
+					},
+				},
+				ReportList: []types.Report{
+					{
+						PostId:   1,
+						Reporter: "alice",
+						Reason:   types.HideReason_HIDE_REASON_SPAM,
+					},
+					{
+						PostId:   1,
+						Reporter: "bob",
+						Reason:   types.HideReason_HIDE_REASON_SPAM,
+					},
+				},
+				ReviewQueue: []uint64{1},
+				Blocklist:   []string{blocked},
+				FeaturedPostList: []types.FeaturedPost{
+					{
+						PostId: 1,
+					},
+					{
+						PostId: 0,
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicated post",
+			genState: &types.GenesisState{
+				PostList: []types.Post{
+					{
+						Id:      0,
+						Creator: creator,
+					},
+					{
+						Id:      0,
+						Creator: creator,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid post count",
+			genState: &types.GenesisState{
+				PostList: []types.Post{
+					{
+						Id:      1,
+						Creator: creator,
+					},
+				},
+				PostCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "post revision of unknown post",
+			genState: &types.GenesisState{
+				PostRevisionList: []types.PostRevision{
+					{
+						PostId:   0,
+						Revision: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "post revision not lower than current revision",
+			genState: &types.GenesisState{
+				PostList: []types.Post{
+					{
+						Id:       0,
+						Creator:  creator,
+						Revision: 1,
+					},
+				},
+				PostCount: 1,
+				PostRevisionList: []types.PostRevision{
+					{
+						PostId:   0,
+						Revision: 1,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated post revision",
+			genState: &types.GenesisState{
+				PostList: []types.Post{
+					{
+						Id:       0,
+						Creator:  creator,
+						Revision: 2,
+					},
+				},
+				PostCount: 1,
+				PostRevisionList: []types.PostRevision{
+					{
+						PostId:   0,
+						Revision: 0,
+					},
+					{
+						PostId:   0,
+						Revision: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated comment",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{{Id: 0, Creator: creator}},
+				PostCount: 1,
+				CommentList: []types.Comment{
+					{
+						PostId: 0,
+						Id:     0,
+					},
+					{
+						PostId: 0,
+						Id:     0,
+					},
+				},
+				CommentCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid comment count",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{{Id: 0, Creator: creator}},
+				PostCount: 1,
+				CommentList: []types.Comment{
+					{
+						PostId: 0,
+						Id:     1,
+					},
+				},
+				CommentCount: 0,
+			},
+			valid: false,
+		},
+		{
+			desc: "comment of unknown post",
+			genState: &types.GenesisState{
+				CommentList: []types.Comment{
+					{
+						PostId: 0,
+						Id:     0,
+					},
+				},
+				CommentCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "reply to unknown comment",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{{Id: 0, Creator: creator}},
+				PostCount: 1,
+				CommentList: []types.Comment{
+					{
+						PostId:   0,
+						Id:       0,
+						ParentId: 1,
+						Depth:    1,
+					},
+				},
+				CommentCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid reply depth",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{{Id: 0, Creator: creator}},
+				PostCount: 1,
+				CommentList: []types.Comment{
+					{
+						PostId: 0,
+						Id:     0,
+					},
+					{
+						PostId:   0,
+						Id:       1,
+						ParentId: 0,
+						Depth:    2,
+					},
+				},
+				CommentCount: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "reaction on unknown post",
+			genState: &types.GenesisState{
+				ReactionList: []types.Reaction{
+					{
+						PostId: 0,
+						Kind:   types.ReactionKind_REACTION_KIND_LIKE,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unspecified reaction kind",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{{Id: 0, Creator: creator}},
+				PostCount: 1,
+				ReactionList: []types.Reaction{
+					{
+						PostId: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated reaction",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{{Id: 0, Creator: creator}},
+				PostCount: 1,
+				ReactionList: []types.Reaction{
+					{
+						PostId:  0,
+						Creator: "alice",
+						Kind:    types.ReactionKind_REACTION_KIND_LIKE,
+					},
+					{
+						PostId:  0,
+						Creator: "alice",
+						Kind:    types.ReactionKind_REACTION_KIND_LIKE,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated author tips",
+			genState: &types.GenesisState{
+				AuthorTipsList: []types.AuthorTips{
+					{
+						Author: "alice",
+					},
+					{
+						Author: "alice",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated author migration",
+			genState: &types.GenesisState{
+				AuthorMigrationList: []types.AuthorMigration{
+					{
+						OldAuthor: "alice",
+						NewAuthor: "bob",
+					},
+					{
+						OldAuthor: "alice",
+						NewAuthor: "carol",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid post creator",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{{Id: 0, Creator: "alice"}},
+				PostCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid original author",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{{Id: 0, Creator: creator, OriginalAuthor: "alice"}},
+				PostCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid post editor",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{{Id: 0, Creator: creator, Editors: []string{"alice"}}},
+				PostCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated post editor",
+			genState: &types.GenesisState{
+				PostList: []types.Post{
+					{
+						Id:      0,
+						Creator: creator,
+						Editors: []string{blocked, blocked},
+					},
+				},
+				PostCount: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "report on unknown post",
+			genState: &types.GenesisState{
+				ReportList: []types.Report{
+					{
+						PostId: 0,
+						Reason: types.HideReason_HIDE_REASON_SPAM,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unspecified report reason",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{{Id: 0, Creator: creator}},
+				PostCount: 1,
+				ReportList: []types.Report{
+					{
+						PostId: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated report",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{{Id: 0, Creator: creator}},
+				PostCount: 1,
+				ReportList: []types.Report{
+					{
+						PostId:   0,
+						Reporter: "alice",
+						Reason:   types.HideReason_HIDE_REASON_SPAM,
+					},
+					{
+						PostId:   0,
+						Reporter: "alice",
+						Reason:   types.HideReason_HIDE_REASON_OTHER,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "review queue holds a visible post",
+			genState: &types.GenesisState{
+				PostList:    []types.Post{{Id: 0, Creator: creator}},
+				PostCount:   1,
+				ReviewQueue: []uint64{0},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated post in review queue",
+			genState: &types.GenesisState{
+				PostList:    []types.Post{{Id: 0, Creator: creator, Hidden: true}},
+				PostCount:   1,
+				ReviewQueue: []uint64{0, 0},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid blocked address",
+			genState: &types.GenesisState{
+				Blocklist: []string{"alice"},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated blocked address",
+			genState: &types.GenesisState{
+				Blocklist: []string{blocked, blocked},
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown featured post",
+			genState: &types.GenesisState{
+				FeaturedPostList: []types.FeaturedPost{
+					{
+						PostId: 0,
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated featured post",
+			genState: &types.GenesisState{
+				PostList:  []types.Post{{Id: 0, Creator: creator}},
+				PostCount: 1,
+				FeaturedPostList: []types.FeaturedPost{
+					{
+						PostId: 0,
+					},
+					{
+						PostId: 0,
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
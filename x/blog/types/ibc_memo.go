@@ -0,0 +1,27 @@
+package types
+
+import "encoding/json"
+
+// TipMemo is the structured ICS-20 transfer memo that turns an incoming
+// transfer into a post tip, e.g. {"blog":{"tip_post":42}}.
+type TipMemo struct {
+	Blog *struct {
+		TipPost *uint64 `json:"tip_post"`
+	} `json:"blog"`
+}
+
+// ParseTipMemo returns the post a transfer memo asks to tip. The second
+// return value is false when the memo is not a well-formed tip memo.
+func ParseTipMemo(memo string) (uint64, bool) {
+	if memo == "" {
+		return 0, false
+	}
+	var m TipMemo
+	if err := json.Unmarshal([]byte(memo), &m); err != nil {
+		return 0, false
+	}
+	if m.Blog == nil || m.Blog.TipPost == nil {
+		return 0, false
+	}
+	return *m.Blog.TipPost, true
+}
@@ -0,0 +1,34 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"blog/x/blog/types"
+)
+
+func TestParseTipMemo(t *testing.T) {
+	tests := []struct {
+		name string
+		memo string
+		id   uint64
+		ok   bool
+	}{
+		{name: "valid", memo: `{"blog":{"tip_post":42}}`, id: 42, ok: true},
+		{name: "with other keys", memo: `{"forward":{},"blog":{"tip_post":0}}`, id: 0, ok: true},
+		{name: "empty", memo: ""},
+		{name: "plain text", memo: "thanks for the post"},
+		{name: "other middleware", memo: `{"wasm":{"contract":"x"}}`},
+		{name: "missing post", memo: `{"blog":{}}`},
+		{name: "negative id", memo: `{"blog":{"tip_post":-1}}`},
+		{name: "string id", memo: `{"blog":{"tip_post":"42"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := types.ParseTipMemo(tt.memo)
+			require.Equal(t, tt.ok, ok)
+			require.Equal(t, tt.id, id)
+		})
+	}
+}
@@ -11,6 +11,52 @@ const (
 	PostKey = "Post/value/"
 	// PostCountKey is used to keep track of the ID of the latest post
 	PostCountKey = "Post/count/"
+	// PostRevisionKey is the prefix under which previous versions of posts are stored
+	PostRevisionKey = "PostRevision/value/"
+	// PostCreatorKey indexes post IDs by their creator
+	PostCreatorKey = "Post/creator/"
+	// PostEditorKey indexes the posts each account is an editor of
+	PostEditorKey = "Post/editor/"
+	// PostAnnouncementKey indexes the official announcements created by governance
+	PostAnnouncementKey = "Post/announcement/"
+	// PostTagKey indexes post IDs by their tags
+	PostTagKey = "Post/tag/"
+	// TagCountKey keeps track of the number of live posts carrying each tag
+	TagCountKey = "Tag/count/"
+	// ReactionKey is the prefix under which reactions are stored by post
+	ReactionKey = "Reaction/value/"
+	// ReactionAccountKey indexes reactions by the account that left them
+	ReactionAccountKey = "Reaction/account/"
+	// ReactionCountKey keeps track of the number of reactions of each kind per post
+	ReactionCountKey = "Reaction/count/"
+	// AuthorTipsKey keeps track of the cumulative tips received by each author
+	AuthorTipsKey = "Tips/author/"
+	// TipRankKey orders posts by the tips they received in each denom
+	TipRankKey = "Tips/rank/"
+	// AuthorMigrationKey keeps track of the author migrations in progress
+	AuthorMigrationKey = "AuthorMigration/value/"
+	// ReportKey is the prefix under which reports are stored by post
+	ReportKey = "Report/value/"
+	// ReportCountKey keeps track of the number of reporters per post
+	ReportCountKey = "Report/count/"
+	// PostReviewQueueKey holds the posts hidden by reports and awaiting review
+	PostReviewQueueKey = "Post/reviewQueue/"
+	// BlocklistKey holds the addresses blocked from posting and interacting
+	BlocklistKey = "Blocklist/value/"
+	// FeaturedPostKey holds the featured posts by display position
+	FeaturedPostKey = "FeaturedPost/value/"
+	// FeaturedPostExpiryQueueKey orders featured posts by the time they expire at
+	FeaturedPostExpiryQueueKey = "FeaturedPost/expiryQueue/"
+	// PostPurgeQueueKey orders deleted posts by the time they are purged at
+	PostPurgeQueueKey = "Post/purgeQueue/"
+	// PostPublishQueueKey orders scheduled drafts by the time they are published at
+	PostPublishQueueKey = "Post/publishQueue/"
+	// CommentKey is used to uniquely identify comments, grouped by post
+	CommentKey = "Comment/value/"
+	// CommentCountKey is used to keep track of the ID of the latest comment
+	CommentCountKey = "Comment/count/"
+	// CommentReplyKey indexes the direct replies of every comment
+	CommentReplyKey = "Comment/reply/"
 )
 
 var (
@@ -0,0 +1,28 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgAddEditor{}
+
+func NewMsgAddEditor(creator string, id uint64, editor string) *MsgAddEditor {
+	return &MsgAddEditor{
+		Creator: creator,
+		Id:      id,
+		Editor:  editor,
+	}
+}
+
+func (msg *MsgAddEditor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Editor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid editor address (%s)", err)
+	}
+	return nil
+}
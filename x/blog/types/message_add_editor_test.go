@@ -0,0 +1,49 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgAddEditor_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgAddEditor
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgAddEditor{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid editor",
+			msg: MsgAddEditor{
+				Creator: sample.AccAddress(),
+				Editor:  "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgAddEditor{
+				Creator: sample.AccAddress(),
+				Editor:  sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateComment{}
+
+func NewMsgCreateComment(creator string, postId uint64, body string) *MsgCreateComment {
+	return &MsgCreateComment{
+		Creator: creator,
+		PostId:  postId,
+		Body:    body,
+	}
+}
+
+func (msg *MsgCreateComment) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return ValidateCommentBody(msg.Body)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -8,11 +10,14 @@ import (
 
 var _ sdk.Msg = &MsgCreatePost{}
 
-func NewMsgCreatePost(creator string, title string, body string) *MsgCreatePost {
+func NewMsgCreatePost(creator string, title string, body string, tags []string, status PostStatus, publishAt *time.Time) *MsgCreatePost {
 	return &MsgCreatePost{
-		Creator: creator,
-		Title:   title,
-		Body:    body,
+		Creator:   creator,
+		Title:     title,
+		Body:      body,
+		Tags:      tags,
+		Status:    status,
+		PublishAt: publishAt,
 	}
 }
 
@@ -21,5 +26,14 @@ func (msg *MsgCreatePost) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if _, err := NormalizeTags(msg.Tags); err != nil {
+		return err
+	}
+	if _, ok := PostStatus_name[int32(msg.Status)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidPostStatus, "unknown post status %d", msg.Status)
+	}
+	if msg.PublishAt != nil && msg.Status != PostStatus_POST_STATUS_DRAFT {
+		return errorsmod.Wrap(ErrInvalidPublishTime, "only drafts can be scheduled")
+	}
 	return nil
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"blog/testutil/sample"
 
@@ -21,10 +22,33 @@ func TestMsgCreatePost_ValidateBasic(t *testing.T) {
 				Creator: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid tag",
+			msg: MsgCreatePost{
+				Creator: sample.AccAddress(),
+				Tags:    []string{"go", "not a tag"},
+			},
+			err: ErrInvalidTag,
+		}, {
+			name: "unknown status",
+			msg: MsgCreatePost{
+				Creator: sample.AccAddress(),
+				Status:  PostStatus(42),
+			},
+			err: ErrInvalidPostStatus,
+		}, {
+			name: "scheduled published post",
+			msg: MsgCreatePost{
+				Creator:   sample.AccAddress(),
+				Status:    PostStatus_POST_STATUS_PUBLISHED,
+				PublishAt: &time.Time{},
+			},
+			err: ErrInvalidPublishTime,
 		}, {
 			name: "valid address",
 			msg: MsgCreatePost{
 				Creator: sample.AccAddress(),
+				Tags:    []string{" Go ", "cosmos-sdk"},
 			},
 		},
 	}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgDeleteComment{}
+
+func NewMsgDeleteComment(creator string, postId uint64, id uint64) *MsgDeleteComment {
+	return &MsgDeleteComment{
+		Creator: creator,
+		PostId:  postId,
+		Id:      id,
+	}
+}
+
+func (msg *MsgDeleteComment) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
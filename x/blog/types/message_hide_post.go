@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgHidePost{}
+
+func NewMsgHidePost(creator string, id uint64, reason HideReason) *MsgHidePost {
+	return &MsgHidePost{
+		Creator: creator,
+		Id:      id,
+		Reason:  reason,
+	}
+}
+
+func (msg *MsgHidePost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return ValidateUserHideReason(msg.Reason)
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgHidePost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgHidePost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgHidePost{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing reason",
+			msg: MsgHidePost{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidHideReason,
+		}, {
+			name: "unknown reason",
+			msg: MsgHidePost{
+				Creator: sample.AccAddress(),
+				Reason:  HideReason(99),
+			},
+			err: ErrInvalidHideReason,
+		}, {
+			name: "reserved reason",
+			msg: MsgHidePost{
+				Creator: sample.AccAddress(),
+				Reason:  HideReason_HIDE_REASON_BLOCKED,
+			},
+			err: ErrInvalidHideReason,
+		}, {
+			name: "valid address",
+			msg: MsgHidePost{
+				Creator: sample.AccAddress(),
+				Reason:  HideReason_HIDE_REASON_SPAM,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
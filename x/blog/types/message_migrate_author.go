@@ -0,0 +1,33 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AuthorMigrationBatchSize is the maximum number of posts moved to a new
+// author by a MsgMigrateAuthor and in each block afterwards.
+const AuthorMigrationBatchSize = 100
+
+var _ sdk.Msg = &MsgMigrateAuthor{}
+
+func NewMsgMigrateAuthor(oldAuthor string, newAuthor string) *MsgMigrateAuthor {
+	return &MsgMigrateAuthor{
+		OldAuthor: oldAuthor,
+		NewAuthor: newAuthor,
+	}
+}
+
+func (msg *MsgMigrateAuthor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.OldAuthor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid old author address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewAuthor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new author address (%s)", err)
+	}
+	if msg.OldAuthor == msg.NewAuthor {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "old and new author are the same")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgMigrateAuthor_ValidateBasic(t *testing.T) {
+	author := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgMigrateAuthor
+		err  error
+	}{
+		{
+			name: "invalid old author",
+			msg: MsgMigrateAuthor{
+				OldAuthor: "invalid_address",
+				NewAuthor: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid new author",
+			msg: MsgMigrateAuthor{
+				OldAuthor: sample.AccAddress(),
+				NewAuthor: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "same author",
+			msg: MsgMigrateAuthor{
+				OldAuthor: author,
+				NewAuthor: author,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgMigrateAuthor{
+				OldAuthor: sample.AccAddress(),
+				NewAuthor: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
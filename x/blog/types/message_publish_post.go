@@ -0,0 +1,27 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgPublishPost{}
+
+func NewMsgPublishPost(creator string, id uint64, publishAt *time.Time) *MsgPublishPost {
+	return &MsgPublishPost{
+		Creator:   creator,
+		Id:        id,
+		PublishAt: publishAt,
+	}
+}
+
+func (msg *MsgPublishPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
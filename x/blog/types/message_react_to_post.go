@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgReactToPost{}
+
+func NewMsgReactToPost(creator string, postId uint64, kind ReactionKind) *MsgReactToPost {
+	return &MsgReactToPost{
+		Creator: creator,
+		PostId:  postId,
+		Kind:    kind,
+	}
+}
+
+func (msg *MsgReactToPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return ValidateReactionKind(msg.Kind)
+}
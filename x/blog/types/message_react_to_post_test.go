@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgReactToPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgReactToPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgReactToPost{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "unspecified kind",
+			msg: MsgReactToPost{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidReactionKind,
+		}, {
+			name: "unknown kind",
+			msg: MsgReactToPost{
+				Creator: sample.AccAddress(),
+				Kind:    ReactionKind(42),
+			},
+			err: ErrInvalidReactionKind,
+		}, {
+			name: "valid address",
+			msg: MsgReactToPost{
+				Creator: sample.AccAddress(),
+				Kind:    ReactionKind_REACTION_KIND_LIKE,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRemoveEditor{}
+
+func NewMsgRemoveEditor(creator string, id uint64, editor string) *MsgRemoveEditor {
+	return &MsgRemoveEditor{
+		Creator: creator,
+		Id:      id,
+		Editor:  editor,
+	}
+}
+
+func (msg *MsgRemoveEditor) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Editor); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid editor address (%s)", err)
+	}
+	return nil
+}
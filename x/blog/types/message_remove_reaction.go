@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgRemoveReaction{}
+
+func NewMsgRemoveReaction(creator string, postId uint64, kind ReactionKind) *MsgRemoveReaction {
+	return &MsgRemoveReaction{
+		Creator: creator,
+		PostId:  postId,
+		Kind:    kind,
+	}
+}
+
+func (msg *MsgRemoveReaction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return ValidateReactionKind(msg.Kind)
+}
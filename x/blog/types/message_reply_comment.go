@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgReplyComment{}
+
+func NewMsgReplyComment(creator string, postId uint64, parentId uint64, body string) *MsgReplyComment {
+	return &MsgReplyComment{
+		Creator:  creator,
+		PostId:   postId,
+		ParentId: parentId,
+		Body:     body,
+	}
+}
+
+func (msg *MsgReplyComment) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return ValidateCommentBody(msg.Body)
+}
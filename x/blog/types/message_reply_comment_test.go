@@ -0,0 +1,63 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgReplyComment_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgReplyComment
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgReplyComment{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty body",
+			msg: MsgReplyComment{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidComment,
+		}, {
+			name: "blank body",
+			msg: MsgReplyComment{
+				Creator: sample.AccAddress(),
+				Body:    " \n\t",
+			},
+			err: ErrInvalidComment,
+		}, {
+			name: "body too long",
+			msg: MsgReplyComment{
+				Creator: sample.AccAddress(),
+				Body:    strings.Repeat("a", MaxCommentBodyBytes+1),
+			},
+			err: ErrInvalidComment,
+		}, {
+			name: "valid address",
+			msg: MsgReplyComment{
+				Creator: sample.AccAddress(),
+				Body:    "body",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
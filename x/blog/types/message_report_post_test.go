@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgReportPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgReportPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgReportPost{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "missing reason",
+			msg: MsgReportPost{
+				Creator: sample.AccAddress(),
+			},
+			err: ErrInvalidHideReason,
+		}, {
+			name: "reserved reason",
+			msg: MsgReportPost{
+				Creator: sample.AccAddress(),
+				Reason:  HideReason_HIDE_REASON_BLOCKED,
+			},
+			err: ErrInvalidHideReason,
+		}, {
+			name: "valid address",
+			msg: MsgReportPost{
+				Creator: sample.AccAddress(),
+				Reason:  HideReason_HIDE_REASON_HARASSMENT,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgReviewPost{}
+
+func NewMsgReviewPost(creator string, id uint64, restore bool) *MsgReviewPost {
+	return &MsgReviewPost{
+		Creator: creator,
+		Id:      id,
+		Restore: restore,
+	}
+}
+
+func (msg *MsgReviewPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgTipPost{}
+
+func NewMsgTipPost(creator string, postId uint64, amount sdk.Coins) *MsgTipPost {
+	return &MsgTipPost{
+		Creator: creator,
+		PostId:  postId,
+		Amount:  amount,
+	}
+}
+
+func (msg *MsgTipPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := msg.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	if msg.Amount.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "tip amount must be positive")
+	}
+	return nil
+}
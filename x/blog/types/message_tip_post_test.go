@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTipPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgTipPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgTipPost{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty amount",
+			msg: MsgTipPost{
+				Creator: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "invalid amount",
+			msg: MsgTipPost{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}},
+			},
+			err: sdkerrors.ErrInvalidCoins,
+		}, {
+			name: "valid address",
+			msg: MsgTipPost{
+				Creator: sample.AccAddress(),
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgTransferPost{}
+
+func NewMsgTransferPost(creator string, id uint64, newOwner string, offer bool) *MsgTransferPost {
+	return &MsgTransferPost{
+		Creator:  creator,
+		Id:       id,
+		NewOwner: newOwner,
+		Offer:    offer,
+	}
+}
+
+func (msg *MsgTransferPost) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address (%s)", err)
+	}
+	if msg.NewOwner == msg.Creator {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "new owner is already the owner")
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgTransferPost_ValidateBasic(t *testing.T) {
+	owner := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgTransferPost
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgTransferPost{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid new owner",
+			msg: MsgTransferPost{
+				Creator:  sample.AccAddress(),
+				NewOwner: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "transfer to self",
+			msg: MsgTransferPost{
+				Creator:  owner,
+				NewOwner: owner,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid address",
+			msg: MsgTransferPost{
+				Creator:  sample.AccAddress(),
+				NewOwner: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
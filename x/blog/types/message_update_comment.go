@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdateComment{}
+
+func NewMsgUpdateComment(creator string, postId uint64, id uint64, body string) *MsgUpdateComment {
+	return &MsgUpdateComment{
+		Creator: creator,
+		PostId:  postId,
+		Id:      id,
+		Body:    body,
+	}
+}
+
+func (msg *MsgUpdateComment) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return ValidateCommentBody(msg.Body)
+}
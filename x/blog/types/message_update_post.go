@@ -8,12 +8,13 @@ import (
 
 var _ sdk.Msg = &MsgUpdatePost{}
 
-func NewMsgUpdatePost(creator string, title string, body string, id uint64) *MsgUpdatePost {
+func NewMsgUpdatePost(creator string, title string, body string, id uint64, tags []string) *MsgUpdatePost {
 	return &MsgUpdatePost{
 		Creator: creator,
 		Title:   title,
 		Body:    body,
 		Id:      id,
+		Tags:    tags,
 	}
 }
 
@@ -22,5 +23,8 @@ func (msg *MsgUpdatePost) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if _, err := NormalizeTags(msg.Tags); err != nil {
+		return err
+	}
 	return nil
 }
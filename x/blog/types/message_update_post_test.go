@@ -21,10 +21,18 @@ func TestMsgUpdatePost_ValidateBasic(t *testing.T) {
 				Creator: "invalid_address",
 			},
 			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid tag",
+			msg: MsgUpdatePost{
+				Creator: sample.AccAddress(),
+				Tags:    []string{"go", "not a tag"},
+			},
+			err: ErrInvalidTag,
 		}, {
 			name: "valid address",
 			msg: MsgUpdatePost{
 				Creator: sample.AccAddress(),
+				Tags:    []string{" Go ", "cosmos-sdk"},
 			},
 		},
 	}
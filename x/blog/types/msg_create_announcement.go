@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgCreateAnnouncement{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateAnnouncement) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if m.Title == "" {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "announcement title cannot be empty")
+	}
+
+	if _, err := NormalizeTags(m.Tags); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateAnnouncement_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateAnnouncement
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg: MsgCreateAnnouncement{
+				Authority: "invalid_address",
+				Title:     "title",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty title",
+			msg: MsgCreateAnnouncement{
+				Authority: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid tag",
+			msg: MsgCreateAnnouncement{
+				Authority: sample.AccAddress(),
+				Title:     "title",
+				Tags:      []string{"not a tag"},
+			},
+			err: ErrInvalidTag,
+		}, {
+			name: "valid announcement",
+			msg: MsgCreateAnnouncement{
+				Authority: sample.AccAddress(),
+				Title:     "title",
+				Body:      "body",
+				Tags:      []string{"upgrade"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgSetFeaturedPosts_ValidateBasic(t *testing.T) {
+	expiresAt := time.Unix(1000, 0)
+	tests := []struct {
+		name string
+		msg  MsgSetFeaturedPosts
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg: MsgSetFeaturedPosts{
+				Authority: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "duplicated post",
+			msg: MsgSetFeaturedPosts{
+				Authority:     sample.AccAddress(),
+				FeaturedPosts: []FeaturedPost{{PostId: 1}, {PostId: 1, ExpiresAt: &expiresAt}},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "empty list",
+			msg: MsgSetFeaturedPosts{
+				Authority: sample.AccAddress(),
+			},
+		}, {
+			name: "valid list",
+			msg: MsgSetFeaturedPosts{
+				Authority:     sample.AccAddress(),
+				FeaturedPosts: []FeaturedPost{{PostId: 2}, {PostId: 1, ExpiresAt: &expiresAt}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
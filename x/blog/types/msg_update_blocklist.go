@@ -0,0 +1,33 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgUpdateBlocklist{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateBlocklist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid authority address (%s)", err)
+	}
+
+	if len(m.Block) == 0 && len(m.Unblock) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no address to block or unblock")
+	}
+
+	seen := make(map[string]bool, len(m.Block)+len(m.Unblock))
+	for _, address := range append(append([]string{}, m.Block...), m.Unblock...) {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address %s (%s)", address, err)
+		}
+		if seen[address] {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "address %s is listed more than once", address)
+		}
+		seen[address] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgUpdateBlocklist_ValidateBasic(t *testing.T) {
+	address := sample.AccAddress()
+	tests := []struct {
+		name string
+		msg  MsgUpdateBlocklist
+		err  error
+	}{
+		{
+			name: "invalid authority",
+			msg: MsgUpdateBlocklist{
+				Authority: "invalid_address",
+				Block:     []string{address},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty update",
+			msg: MsgUpdateBlocklist{
+				Authority: sample.AccAddress(),
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "invalid blocked address",
+			msg: MsgUpdateBlocklist{
+				Authority: sample.AccAddress(),
+				Block:     []string{"invalid_address"},
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "blocked and unblocked",
+			msg: MsgUpdateBlocklist{
+				Authority: sample.AccAddress(),
+				Block:     []string{address},
+				Unblock:   []string{address},
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		}, {
+			name: "valid update",
+			msg: MsgUpdateBlocklist{
+				Authority: sample.AccAddress(),
+				Block:     []string{address},
+				Unblock:   []string{sample.AccAddress()},
+				HidePosts: true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// PostNFTClassID is the x/nft class under which posts are minted.
+	PostNFTClassID = "blog"
+	// PostNFTClassName is the human readable name of the post NFT class.
+	PostNFTClassName = "Blog posts"
+	// PostNFTClassSymbol is the symbol of the post NFT class.
+	PostNFTClassSymbol = "POST"
+)
+
+// PostNFTID returns the x/nft token id of a post.
+func PostNFTID(id uint64) string {
+	return fmt.Sprintf("post-%d", id)
+}
+
+// ParsePostNFTID returns the id of the post an x/nft token id belongs to.
+func ParsePostNFTID(nftID string) (uint64, bool) {
+	suffix, ok := strings.CutPrefix(nftID, "post-")
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(suffix, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
@@ -1,32 +1,339 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+var (
+	KeyMaxRevisions = []byte("MaxRevisions")
+	// DefaultMaxRevisions is the default number of previous versions kept per post
+	DefaultMaxRevisions uint64 = 20
+
+	KeyDeleteGracePeriod = []byte("DeleteGracePeriod")
+	// DefaultDeleteGracePeriod is the default time a deleted post can be restored
+	DefaultDeleteGracePeriod = 7 * 24 * time.Hour
+
+	KeyMaxCommentDepth = []byte("MaxCommentDepth")
+	// DefaultMaxCommentDepth is the default deepest nesting level of a reply
+	DefaultMaxCommentDepth uint64 = 5
+
+	KeyMaxTags = []byte("MaxTags")
+	// DefaultMaxTags is the default number of tags a post may carry
+	DefaultMaxTags uint64 = 5
+
+	KeyMaxTagLength = []byte("MaxTagLength")
+	// DefaultMaxTagLength is the default longest tag in bytes
+	DefaultMaxTagLength uint64 = 32
+
+	KeyMaxTitleBytes = []byte("MaxTitleBytes")
+	// DefaultMaxTitleBytes is the default longest post title in bytes
+	DefaultMaxTitleBytes uint64 = 256
+
+	KeyMaxBodyBytes = []byte("MaxBodyBytes")
+	// DefaultMaxBodyBytes is the default longest post body in bytes
+	DefaultMaxBodyBytes uint64 = 64 * 1024
+
+	KeyPostFee = []byte("PostFee")
+	// DefaultPostFee is the default fee charged for creating a post
+	DefaultPostFee = sdk.NewCoin(sdk.DefaultBondDenom, math.ZeroInt())
+
+	KeyDepositPerByte = []byte("DepositPerByte")
+	// DefaultDepositPerByte is the default storage deposit per byte of a post
+	DefaultDepositPerByte = sdk.NewCoin(sdk.DefaultBondDenom, math.ZeroInt())
+
+	KeyModerators = []byte("Moderators")
+	// DefaultModerators is the default set of accounts allowed to hide posts
+	DefaultModerators []string
+
+	KeyReportThreshold = []byte("ReportThreshold")
+	// DefaultReportThreshold is the default number of reporters that hides a post
+	DefaultReportThreshold uint64 = 5
+)
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	maxRevisions uint64,
+	deleteGracePeriod time.Duration,
+	maxCommentDepth uint64,
+	maxTags uint64,
+	maxTagLength uint64,
+	maxTitleBytes uint64,
+	maxBodyBytes uint64,
+	postFee sdk.Coin,
+	depositPerByte sdk.Coin,
+	moderators []string,
+	reportThreshold uint64,
+) Params {
+	return Params{
+		MaxRevisions:      maxRevisions,
+		DeleteGracePeriod: deleteGracePeriod,
+		MaxCommentDepth:   maxCommentDepth,
+		MaxTags:           maxTags,
+		MaxTagLength:      maxTagLength,
+		MaxTitleBytes:     maxTitleBytes,
+		MaxBodyBytes:      maxBodyBytes,
+		PostFee:           postFee,
+		DepositPerByte:    depositPerByte,
+		Moderators:        moderators,
+		ReportThreshold:   reportThreshold,
+	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultMaxRevisions,
+		DefaultDeleteGracePeriod,
+		DefaultMaxCommentDepth,
+		DefaultMaxTags,
+		DefaultMaxTagLength,
+		DefaultMaxTitleBytes,
+		DefaultMaxBodyBytes,
+		DefaultPostFee,
+		DefaultDepositPerByte,
+		DefaultModerators,
+		DefaultReportThreshold,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMaxRevisions, &p.MaxRevisions, validateMaxRevisions),
+		paramtypes.NewParamSetPair(KeyDeleteGracePeriod, &p.DeleteGracePeriod, validateDeleteGracePeriod),
+		paramtypes.NewParamSetPair(KeyMaxCommentDepth, &p.MaxCommentDepth, validateMaxCommentDepth),
+		paramtypes.NewParamSetPair(KeyMaxTags, &p.MaxTags, validateMaxTags),
+		paramtypes.NewParamSetPair(KeyMaxTagLength, &p.MaxTagLength, validateMaxTagLength),
+		paramtypes.NewParamSetPair(KeyMaxTitleBytes, &p.MaxTitleBytes, validateMaxTitleBytes),
+		paramtypes.NewParamSetPair(KeyMaxBodyBytes, &p.MaxBodyBytes, validateMaxBodyBytes),
+		paramtypes.NewParamSetPair(KeyPostFee, &p.PostFee, validatePostFee),
+		paramtypes.NewParamSetPair(KeyDepositPerByte, &p.DepositPerByte, validateDepositPerByte),
+		paramtypes.NewParamSetPair(KeyModerators, &p.Moderators, validateModerators),
+		paramtypes.NewParamSetPair(KeyReportThreshold, &p.ReportThreshold, validateReportThreshold),
+	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
+	if err := validateMaxRevisions(p.MaxRevisions); err != nil {
+		return err
+	}
+
+	if err := validateDeleteGracePeriod(p.DeleteGracePeriod); err != nil {
+		return err
+	}
+
+	if err := validateMaxCommentDepth(p.MaxCommentDepth); err != nil {
+		return err
+	}
+
+	if err := validateMaxTags(p.MaxTags); err != nil {
+		return err
+	}
+
+	if err := validateMaxTagLength(p.MaxTagLength); err != nil {
+		return err
+	}
+
+	if err := validateMaxTitleBytes(p.MaxTitleBytes); err != nil {
+		return err
+	}
+
+	if err := validateMaxBodyBytes(p.MaxBodyBytes); err != nil {
+		return err
+	}
+
+	if err := validatePostFee(p.PostFee); err != nil {
+		return err
+	}
+
+	if err := validateDepositPerByte(p.DepositPerByte); err != nil {
+		return err
+	}
+
+	if err := validateModerators(p.Moderators); err != nil {
+		return err
+	}
+
+	if err := validateReportThreshold(p.ReportThreshold); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateMaxRevisions validates the MaxRevisions param
+func validateMaxRevisions(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateDeleteGracePeriod validates the DeleteGracePeriod param
+func validateDeleteGracePeriod(v interface{}) error {
+	deleteGracePeriod, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if deleteGracePeriod < 0 {
+		return fmt.Errorf("delete grace period must not be negative: %s", deleteGracePeriod)
+	}
+
+	return nil
+}
+
+// validateMaxCommentDepth validates the MaxCommentDepth param
+func validateMaxCommentDepth(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxTags validates the MaxTags param
+func validateMaxTags(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxTagLength validates the MaxTagLength param
+func validateMaxTagLength(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// validateMaxTitleBytes validates the MaxTitleBytes param
+func validateMaxTitleBytes(v interface{}) error {
+	maxTitleBytes, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxTitleBytes == 0 {
+		return fmt.Errorf("max title bytes must be positive")
+	}
+
+	return nil
+}
+
+// validateMaxBodyBytes validates the MaxBodyBytes param
+func validateMaxBodyBytes(v interface{}) error {
+	maxBodyBytes, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if maxBodyBytes == 0 {
+		return fmt.Errorf("max body bytes must be positive")
+	}
+
+	return nil
+}
+
+// validatePostFee validates the PostFee param
+func validatePostFee(v interface{}) error {
+	postFee, ok := v.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := postFee.Validate(); err != nil {
+		return fmt.Errorf("invalid post fee: %w", err)
+	}
+
+	return nil
+}
+
+// validateDepositPerByte validates the DepositPerByte param
+func validateDepositPerByte(v interface{}) error {
+	depositPerByte, ok := v.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := depositPerByte.Validate(); err != nil {
+		return fmt.Errorf("invalid deposit per byte: %w", err)
+	}
+
+	return nil
+}
+
+// validateModerators validates the Moderators param
+func validateModerators(v interface{}) error {
+	moderators, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]struct{}, len(moderators))
+	for _, moderator := range moderators {
+		if _, err := sdk.AccAddressFromBech32(moderator); err != nil {
+			return fmt.Errorf("invalid moderator address %s: %w", moderator, err)
+		}
+		if _, ok := seen[moderator]; ok {
+			return fmt.Errorf("duplicated moderator %s", moderator)
+		}
+		seen[moderator] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateReportThreshold validates the ReportThreshold param
+func validateReportThreshold(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+// IsModerator reports whether the account is in the moderator set.
+func (p Params) IsModerator(account string) bool {
+	for _, moderator := range p.Moderators {
+		if moderator == account {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatePostContent checks the title and body of a post against the size
+// limits in params.
+func (p Params) ValidatePostContent(title, body string) error {
+	if uint64(len(title)) > p.MaxTitleBytes {
+		return errorsmod.Wrapf(ErrTitleTooLong, "title is %d bytes, maximum is %d", len(title), p.MaxTitleBytes)
+	}
+	if uint64(len(body)) > p.MaxBodyBytes {
+		return errorsmod.Wrapf(ErrBodyTooLong, "body is %d bytes, maximum is %d", len(body), p.MaxBodyBytes)
+	}
 	return nil
 }
@@ -5,18 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +31,34 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// max_revisions is the number of previous versions kept for each post.
+	// Older revisions are pruned on update; zero disables the history.
+	MaxRevisions uint64 `protobuf:"varint,1,opt,name=max_revisions,json=maxRevisions,proto3" json:"max_revisions,omitempty" yaml:"max_revisions"`
+	// delete_grace_period is how long a deleted post can still be restored by
+	// its creator before it is permanently purged.
+	DeleteGracePeriod time.Duration `protobuf:"bytes,2,opt,name=delete_grace_period,json=deleteGracePeriod,proto3,stdduration" json:"delete_grace_period" yaml:"delete_grace_period"`
+	// max_comment_depth is the deepest nesting level a reply may have. Zero
+	// disables replies to comments.
+	MaxCommentDepth uint64 `protobuf:"varint,3,opt,name=max_comment_depth,json=maxCommentDepth,proto3" json:"max_comment_depth,omitempty" yaml:"max_comment_depth"`
+	// max_tags is the number of tags a post may carry. Zero disables tags.
+	MaxTags uint64 `protobuf:"varint,4,opt,name=max_tags,json=maxTags,proto3" json:"max_tags,omitempty" yaml:"max_tags"`
+	// max_tag_length is the longest a single normalized tag may be, in bytes.
+	MaxTagLength uint64 `protobuf:"varint,5,opt,name=max_tag_length,json=maxTagLength,proto3" json:"max_tag_length,omitempty" yaml:"max_tag_length"`
+	// max_title_bytes is the longest a post title may be, in bytes.
+	MaxTitleBytes uint64 `protobuf:"varint,6,opt,name=max_title_bytes,json=maxTitleBytes,proto3" json:"max_title_bytes,omitempty" yaml:"max_title_bytes"`
+	// max_body_bytes is the longest a post body may be, in bytes.
+	MaxBodyBytes uint64 `protobuf:"varint,7,opt,name=max_body_bytes,json=maxBodyBytes,proto3" json:"max_body_bytes,omitempty" yaml:"max_body_bytes"`
+	// post_fee is charged to the creator of every post and paid into the blog
+	// module account.
+	PostFee types.Coin `protobuf:"bytes,8,opt,name=post_fee,json=postFee,proto3" json:"post_fee" yaml:"post_fee"`
+	// deposit_per_byte is escrowed in the blog module account for every byte
+	// of a post title and body, and refunded when the post is deleted.
+	DepositPerByte types.Coin `protobuf:"bytes,9,opt,name=deposit_per_byte,json=depositPerByte,proto3" json:"deposit_per_byte" yaml:"deposit_per_byte"`
+	// moderators are the accounts allowed to hide and unhide posts.
+	Moderators []string `protobuf:"bytes,10,rep,name=moderators,proto3" json:"moderators,omitempty" yaml:"moderators"`
+	// report_threshold is the number of distinct reporters at which a post is
+	// hidden and queued for review. Zero disables automatic hiding.
+	ReportThreshold uint64 `protobuf:"varint,11,opt,name=report_threshold,json=reportThreshold,proto3" json:"report_threshold,omitempty" yaml:"report_threshold"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +94,83 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxRevisions() uint64 {
+	if m != nil {
+		return m.MaxRevisions
+	}
+	return 0
+}
+
+func (m *Params) GetDeleteGracePeriod() time.Duration {
+	if m != nil {
+		return m.DeleteGracePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCommentDepth() uint64 {
+	if m != nil {
+		return m.MaxCommentDepth
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTags() uint64 {
+	if m != nil {
+		return m.MaxTags
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTagLength() uint64 {
+	if m != nil {
+		return m.MaxTagLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTitleBytes() uint64 {
+	if m != nil {
+		return m.MaxTitleBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBodyBytes() uint64 {
+	if m != nil {
+		return m.MaxBodyBytes
+	}
+	return 0
+}
+
+func (m *Params) GetPostFee() types.Coin {
+	if m != nil {
+		return m.PostFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetDepositPerByte() types.Coin {
+	if m != nil {
+		return m.DepositPerByte
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetModerators() []string {
+	if m != nil {
+		return m.Moderators
+	}
+	return nil
+}
+
+func (m *Params) GetReportThreshold() uint64 {
+	if m != nil {
+		return m.ReportThreshold
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "blog.blog.Params")
 }
@@ -68,16 +178,45 @@ func init() {
 func init() { proto.RegisterFile("blog/blog/params.proto", fileDescriptor_4090b74576102d17) }
 
 var fileDescriptor_4090b74576102d17 = []byte{
-	// 143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0xca, 0xc9, 0x4f,
-	0xd7, 0x07, 0x13, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42,
-	0x9c, 0x20, 0x21, 0x3d, 0x10, 0x21, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21,
-	0xb2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0x52, 0xe5,
-	0x62, 0x0b, 0x00, 0x9b, 0x61, 0x25, 0xfd, 0x62, 0x81, 0x3c, 0x63, 0xd7, 0xf3, 0x0d, 0x5a, 0x42,
-	0x60, 0x93, 0x2b, 0x20, 0x16, 0x40, 0x24, 0x9d, 0xb4, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48,
-	0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1,
-	0x58, 0x8e, 0x21, 0x4a, 0x10, 0x59, 0x75, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x68,
-	0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x14, 0x81, 0xa3, 0x47, 0xa8, 0x00, 0x00, 0x00,
+	// 602 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcf, 0x4f, 0xd4, 0x40,
+	0x18, 0xdd, 0x0a, 0x2e, 0x30, 0x28, 0xcb, 0x96, 0x1f, 0x96, 0x85, 0xb4, 0xa4, 0x31, 0x86, 0x60,
+	0xd2, 0x06, 0x8d, 0x17, 0x12, 0x63, 0x52, 0x08, 0x7a, 0xf0, 0x40, 0x9a, 0x3d, 0x79, 0x69, 0xa6,
+	0xdb, 0xa1, 0xdb, 0xa4, 0xed, 0x57, 0x3b, 0x03, 0xd9, 0xfd, 0x17, 0x3c, 0x79, 0xf4, 0xe8, 0xd1,
+	0x23, 0x7f, 0x06, 0x47, 0x2e, 0x26, 0x9e, 0xaa, 0x81, 0x03, 0x9e, 0xfb, 0x17, 0x98, 0xf9, 0x51,
+	0x77, 0xd7, 0x90, 0x78, 0x99, 0xf4, 0x7b, 0xef, 0xcd, 0x9b, 0xd7, 0x99, 0xef, 0x43, 0x9b, 0x61,
+	0x0a, 0xb1, 0x2b, 0x96, 0x02, 0x97, 0x38, 0xa3, 0x4e, 0x51, 0x02, 0x03, 0x7d, 0x89, 0x43, 0x0e,
+	0x5f, 0x7a, 0x5d, 0x9c, 0x25, 0x39, 0xb8, 0x62, 0x95, 0x6c, 0x6f, 0x3d, 0x86, 0x18, 0xc4, 0xa7,
+	0xcb, 0xbf, 0x14, 0x6a, 0xc6, 0x00, 0x71, 0x4a, 0x5c, 0x51, 0x85, 0xe7, 0x67, 0x6e, 0x74, 0x5e,
+	0x62, 0x96, 0x40, 0xde, 0xf0, 0x03, 0xa0, 0x19, 0x50, 0x37, 0xc4, 0x94, 0xb8, 0x17, 0x07, 0x21,
+	0x61, 0xf8, 0xc0, 0x1d, 0x40, 0xa2, 0x78, 0xfb, 0x7b, 0x1b, 0xb5, 0x4f, 0x45, 0x08, 0xfd, 0x35,
+	0x7a, 0x9c, 0xe1, 0x51, 0x50, 0x92, 0x8b, 0x84, 0x26, 0x90, 0x53, 0x43, 0xdb, 0xd5, 0xf6, 0xe6,
+	0x3d, 0xa3, 0xae, 0xac, 0xf5, 0x31, 0xce, 0xd2, 0x43, 0x7b, 0x86, 0xb6, 0xfd, 0x47, 0x19, 0x1e,
+	0xf9, 0x4d, 0xa9, 0x7f, 0x44, 0x6b, 0x11, 0x49, 0x09, 0x23, 0x41, 0x5c, 0xe2, 0x01, 0x09, 0x0a,
+	0x52, 0x26, 0x10, 0x19, 0x0f, 0x76, 0xb5, 0xbd, 0xe5, 0x17, 0x5b, 0x8e, 0xcc, 0xe9, 0x34, 0x39,
+	0x9d, 0x63, 0x95, 0xd3, 0x7b, 0x76, 0x55, 0x59, 0xad, 0xba, 0xb2, 0x7a, 0xf2, 0x8c, 0x7b, 0x3c,
+	0xec, 0x2f, 0x3f, 0x2d, 0xcd, 0xef, 0x4a, 0xe6, 0x2d, 0x27, 0x4e, 0x05, 0xae, 0xbf, 0x43, 0x5d,
+	0x1e, 0x69, 0x00, 0x59, 0x46, 0x72, 0x16, 0x44, 0xa4, 0x60, 0x43, 0x63, 0x4e, 0xa4, 0xde, 0xa9,
+	0x2b, 0xcb, 0x98, 0xa4, 0x9e, 0x91, 0xd8, 0x7e, 0x27, 0xc3, 0xa3, 0x23, 0x09, 0x1d, 0x73, 0x44,
+	0x77, 0xd0, 0x22, 0x97, 0x31, 0x1c, 0x53, 0x63, 0x5e, 0x18, 0xac, 0xd5, 0x95, 0xd5, 0x99, 0x18,
+	0x70, 0xc6, 0xf6, 0x17, 0x32, 0x3c, 0xea, 0xe3, 0x98, 0xea, 0x6f, 0xd0, 0x8a, 0x42, 0x83, 0x94,
+	0xe4, 0x31, 0x1b, 0x1a, 0x0f, 0xc5, 0xae, 0xad, 0xba, 0xb2, 0x36, 0x66, 0x76, 0x29, 0x5e, 0xde,
+	0x56, 0x1f, 0xc7, 0xef, 0x45, 0xa9, 0x7b, 0xa8, 0x23, 0x04, 0x09, 0x4b, 0x49, 0x10, 0x8e, 0x19,
+	0xa1, 0x46, 0x5b, 0x38, 0xf4, 0xea, 0xca, 0xda, 0x9c, 0x72, 0x98, 0x08, 0x6c, 0x9f, 0xbf, 0x4f,
+	0x9f, 0x03, 0x1e, 0xaf, 0x9b, 0x10, 0x21, 0x44, 0x63, 0x65, 0xb1, 0x70, 0x5f, 0x88, 0x09, 0x2f,
+	0x43, 0x78, 0x10, 0x8d, 0xa5, 0x81, 0x8f, 0x16, 0x0b, 0xa0, 0x2c, 0x38, 0x23, 0xc4, 0x58, 0x54,
+	0xef, 0x24, 0xfb, 0xc5, 0xe1, 0xfd, 0xe2, 0xa8, 0x7e, 0x71, 0x8e, 0x20, 0xc9, 0xbd, 0x1d, 0xf5,
+	0x4e, 0xea, 0x52, 0x9a, 0x8d, 0xf6, 0xb7, 0xbb, 0xcb, 0x7d, 0xcd, 0x5f, 0xe0, 0xf5, 0x09, 0x21,
+	0x7a, 0x82, 0x56, 0x23, 0x52, 0x00, 0x4d, 0x18, 0x7f, 0x3d, 0x71, 0xae, 0xb1, 0xf4, 0x3f, 0xef,
+	0xa7, 0xca, 0xfb, 0x49, 0xd3, 0x03, 0xb3, 0x06, 0xea, 0x8c, 0x15, 0x85, 0x9f, 0x92, 0x92, 0xe7,
+	0xd7, 0x5f, 0x21, 0x94, 0x41, 0x44, 0x4a, 0xcc, 0xa0, 0xa4, 0x06, 0xda, 0x9d, 0xdb, 0x5b, 0xf2,
+	0x36, 0xea, 0xca, 0xea, 0xaa, 0x7f, 0xff, 0xcb, 0xd9, 0xfe, 0x94, 0x50, 0x3f, 0x41, 0xab, 0x25,
+	0x29, 0xa0, 0x64, 0x01, 0x1b, 0x96, 0x84, 0x0e, 0x21, 0x8d, 0x8c, 0x65, 0x71, 0x71, 0xdb, 0x93,
+	0x08, 0xff, 0x2a, 0x6c, 0xbf, 0x23, 0xa1, 0x7e, 0x83, 0x1c, 0x6e, 0xff, 0xfe, 0x6a, 0x69, 0x9f,
+	0xee, 0x2e, 0xf7, 0x75, 0x31, 0xca, 0x23, 0x39, 0xd1, 0x72, 0x98, 0xbc, 0xe7, 0x57, 0x37, 0xa6,
+	0x76, 0x7d, 0x63, 0x6a, 0xbf, 0x6e, 0x4c, 0xed, 0xf3, 0xad, 0xd9, 0xba, 0xbe, 0x35, 0x5b, 0x3f,
+	0x6e, 0xcd, 0xd6, 0x87, 0xee, 0xb4, 0x9a, 0x8d, 0x0b, 0x42, 0xc3, 0xb6, 0x98, 0x8a, 0x97, 0x7f,
+	0x06, 0x00, 0x10, 0xbe, 0x27, 0xa9, 0x19, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -99,6 +238,44 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxRevisions != that1.MaxRevisions {
+		return false
+	}
+	if this.DeleteGracePeriod != that1.DeleteGracePeriod {
+		return false
+	}
+	if this.MaxCommentDepth != that1.MaxCommentDepth {
+		return false
+	}
+	if this.MaxTags != that1.MaxTags {
+		return false
+	}
+	if this.MaxTagLength != that1.MaxTagLength {
+		return false
+	}
+	if this.MaxTitleBytes != that1.MaxTitleBytes {
+		return false
+	}
+	if this.MaxBodyBytes != that1.MaxBodyBytes {
+		return false
+	}
+	if !this.PostFee.Equal(&that1.PostFee) {
+		return false
+	}
+	if !this.DepositPerByte.Equal(&that1.DepositPerByte) {
+		return false
+	}
+	if len(this.Moderators) != len(that1.Moderators) {
+		return false
+	}
+	for i := range this.Moderators {
+		if this.Moderators[i] != that1.Moderators[i] {
+			return false
+		}
+	}
+	if this.ReportThreshold != that1.ReportThreshold {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +298,78 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReportThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReportThreshold))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Moderators) > 0 {
+		for iNdEx := len(m.Moderators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Moderators[iNdEx])
+			copy(dAtA[i:], m.Moderators[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Moderators[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.DepositPerByte.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.PostFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.MaxBodyBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBodyBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxTitleBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTitleBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxTagLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTagLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxTags != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTags))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxCommentDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCommentDepth))
+		i--
+		dAtA[i] = 0x18
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DeleteGracePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DeleteGracePeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.MaxRevisions != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRevisions))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -141,6 +390,39 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxRevisions != 0 {
+		n += 1 + sovParams(uint64(m.MaxRevisions))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DeleteGracePeriod)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxCommentDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxCommentDepth))
+	}
+	if m.MaxTags != 0 {
+		n += 1 + sovParams(uint64(m.MaxTags))
+	}
+	if m.MaxTagLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxTagLength))
+	}
+	if m.MaxTitleBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxTitleBytes))
+	}
+	if m.MaxBodyBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxBodyBytes))
+	}
+	l = m.PostFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.DepositPerByte.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Moderators) > 0 {
+		for _, s := range m.Moderators {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.ReportThreshold != 0 {
+		n += 1 + sovParams(uint64(m.ReportThreshold))
+	}
 	return n
 }
 
@@ -179,6 +461,270 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRevisions", wireType)
+			}
+			m.MaxRevisions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRevisions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteGracePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DeleteGracePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCommentDepth", wireType)
+			}
+			m.MaxCommentDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCommentDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTags", wireType)
+			}
+			m.MaxTags = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTags |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTagLength", wireType)
+			}
+			m.MaxTagLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTagLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTitleBytes", wireType)
+			}
+			m.MaxTitleBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTitleBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBodyBytes", wireType)
+			}
+			m.MaxBodyBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBodyBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PostFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositPerByte", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositPerByte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moderators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moderators = append(m.Moderators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportThreshold", wireType)
+			}
+			m.ReportThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
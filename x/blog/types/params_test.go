@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	"blog/testutil/sample"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams_Validate(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+
+	params := DefaultParams()
+	params.MaxTitleBytes = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MaxBodyBytes = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.DeleteGracePeriod = -1
+	require.Error(t, params.Validate())
+
+	moderator := sample.AccAddress()
+	params = DefaultParams()
+	params.Moderators = []string{moderator}
+	require.NoError(t, params.Validate())
+	require.True(t, params.IsModerator(moderator))
+	require.False(t, params.IsModerator(sample.AccAddress()))
+
+	params.Moderators = []string{moderator, moderator}
+	require.Error(t, params.Validate())
+
+	params.Moderators = []string{"invalid_address"}
+	require.Error(t, params.Validate())
+}
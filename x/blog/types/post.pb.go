@@ -5,16 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -22,11 +29,137 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// PostStatus is the visibility of a post.
+type PostStatus int32
+
+const (
+	PostStatus_POST_STATUS_UNSPECIFIED PostStatus = 0
+	// POST_STATUS_DRAFT posts are not listed until they are published.
+	PostStatus_POST_STATUS_DRAFT PostStatus = 1
+	// POST_STATUS_PUBLISHED posts are listed publicly.
+	PostStatus_POST_STATUS_PUBLISHED PostStatus = 2
+	// POST_STATUS_UNLISTED posts can be shown by ID but are not listed.
+	PostStatus_POST_STATUS_UNLISTED PostStatus = 3
+)
+
+var PostStatus_name = map[int32]string{
+	0: "POST_STATUS_UNSPECIFIED",
+	1: "POST_STATUS_DRAFT",
+	2: "POST_STATUS_PUBLISHED",
+	3: "POST_STATUS_UNLISTED",
+}
+
+var PostStatus_value = map[string]int32{
+	"POST_STATUS_UNSPECIFIED": 0,
+	"POST_STATUS_DRAFT":       1,
+	"POST_STATUS_PUBLISHED":   2,
+	"POST_STATUS_UNLISTED":    3,
+}
+
+func (x PostStatus) String() string {
+	return proto.EnumName(PostStatus_name, int32(x))
+}
+
+func (PostStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f060607f92e3b72, []int{0}
+}
+
+// HideReason is the reason a moderator hid a post for.
+type HideReason int32
+
+const (
+	HideReason_HIDE_REASON_UNSPECIFIED HideReason = 0
+	HideReason_HIDE_REASON_SPAM        HideReason = 1
+	HideReason_HIDE_REASON_HARASSMENT  HideReason = 2
+	HideReason_HIDE_REASON_ILLEGAL     HideReason = 3
+	HideReason_HIDE_REASON_OFF_TOPIC   HideReason = 4
+	HideReason_HIDE_REASON_OTHER       HideReason = 5
+	// HIDE_REASON_BLOCKED is set on the posts of an author put on the blocklist.
+	HideReason_HIDE_REASON_BLOCKED HideReason = 6
+)
+
+var HideReason_name = map[int32]string{
+	0: "HIDE_REASON_UNSPECIFIED",
+	1: "HIDE_REASON_SPAM",
+	2: "HIDE_REASON_HARASSMENT",
+	3: "HIDE_REASON_ILLEGAL",
+	4: "HIDE_REASON_OFF_TOPIC",
+	5: "HIDE_REASON_OTHER",
+	6: "HIDE_REASON_BLOCKED",
+}
+
+var HideReason_value = map[string]int32{
+	"HIDE_REASON_UNSPECIFIED": 0,
+	"HIDE_REASON_SPAM":        1,
+	"HIDE_REASON_HARASSMENT":  2,
+	"HIDE_REASON_ILLEGAL":     3,
+	"HIDE_REASON_OFF_TOPIC":   4,
+	"HIDE_REASON_OTHER":       5,
+	"HIDE_REASON_BLOCKED":     6,
+}
+
+func (x HideReason) String() string {
+	return proto.EnumName(HideReason_name, int32(x))
+}
+
+func (HideReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8f060607f92e3b72, []int{1}
+}
+
 type Post struct {
-	Title   string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Body    string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body  string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// creator is the current owner of the post.
 	Creator string `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
 	Id      uint64 `protobuf:"varint,4,opt,name=id,proto3" json:"id,omitempty"`
+	// created_at is the block time at which the post was created.
+	CreatedAt time.Time `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// created_height is the block height at which the post was created.
+	CreatedHeight int64 `protobuf:"varint,6,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// updated_at is the block time of the latest edit, equal to created_at
+	// until the post is first updated.
+	UpdatedAt time.Time `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3,stdtime" json:"updated_at"`
+	// updated_height is the block height of the latest edit.
+	UpdatedHeight int64 `protobuf:"varint,8,opt,name=updated_height,json=updatedHeight,proto3" json:"updated_height,omitempty"`
+	// revision is the number of the current version. It starts at zero and is
+	// incremented every time the post is updated.
+	Revision uint64 `protobuf:"varint,9,opt,name=revision,proto3" json:"revision,omitempty"`
+	// deleted marks a tombstoned post that can still be restored until purge_at.
+	Deleted bool `protobuf:"varint,10,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// deleted_at is the block time at which the post was deleted.
+	DeletedAt time.Time `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3,stdtime" json:"deleted_at"`
+	// purge_at is the time after which a deleted post is permanently removed.
+	PurgeAt time.Time `protobuf:"bytes,12,opt,name=purge_at,json=purgeAt,proto3,stdtime" json:"purge_at"`
+	// tags are the normalized topics the post is indexed under.
+	Tags   []string   `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
+	Status PostStatus `protobuf:"varint,14,opt,name=status,proto3,enum=blog.blog.PostStatus" json:"status,omitempty"`
+	// publish_at is the time a draft is scheduled to be published at, or the
+	// time the post was published once it is.
+	PublishAt time.Time `protobuf:"bytes,15,opt,name=publish_at,json=publishAt,proto3,stdtime" json:"publish_at"`
+	// deposit is the storage deposit escrowed for the post, refunded to its
+	// creator when the post is deleted.
+	Deposit types.Coin `protobuf:"bytes,16,opt,name=deposit,proto3" json:"deposit"`
+	// tips is the cumulative amount of tips sent to the post.
+	Tips github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,17,rep,name=tips,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tips"`
+	// deleted_by is the account that deleted the post. Only it may restore the
+	// post, since the post's NFT is burned on deletion.
+	DeletedBy string `protobuf:"bytes,18,opt,name=deleted_by,json=deletedBy,proto3" json:"deleted_by,omitempty"`
+	// original_author is the account that created the post. Unlike creator,
+	// which follows the current owner, it never changes.
+	OriginalAuthor string `protobuf:"bytes,19,opt,name=original_author,json=originalAuthor,proto3" json:"original_author,omitempty"`
+	// pending_owner is the account a transfer of the post was offered to.
+	PendingOwner string `protobuf:"bytes,20,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+	// editors are the accounts the owner allowed to update the post.
+	Editors []string `protobuf:"bytes,21,rep,name=editors,proto3" json:"editors,omitempty"`
+	// hidden marks a post a moderator hid. Hidden posts are not listed.
+	Hidden bool `protobuf:"varint,22,opt,name=hidden,proto3" json:"hidden,omitempty"`
+	// hide_reason is the reason the post was hidden for.
+	HideReason HideReason `protobuf:"varint,23,opt,name=hide_reason,json=hideReason,proto3,enum=blog.blog.HideReason" json:"hide_reason,omitempty"`
+	// hidden_by is the moderator that hid the post, or that confirmed the hide
+	// of a reported post. It is empty while a reported post awaits review.
+	HiddenBy string `protobuf:"bytes,24,opt,name=hidden_by,json=hiddenBy,proto3" json:"hidden_by,omitempty"`
+	// official marks an announcement created by governance.
+	Official bool `protobuf:"varint,25,opt,name=official,proto3" json:"official,omitempty"`
 }
 
 func (m *Post) Reset()         { *m = Post{} }
@@ -47,161 +180,1573 @@ func (m *Post) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		if err != nil {
 			return nil, err
 		}
-		return b[:n], nil
-	}
-}
-func (m *Post) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Post.Merge(m, src)
-}
-func (m *Post) XXX_Size() int {
-	return m.Size()
-}
-func (m *Post) XXX_DiscardUnknown() {
-	xxx_messageInfo_Post.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Post proto.InternalMessageInfo
-
-func (m *Post) GetTitle() string {
-	if m != nil {
-		return m.Title
-	}
-	return ""
-}
-
-func (m *Post) GetBody() string {
-	if m != nil {
-		return m.Body
-	}
-	return ""
-}
-
-func (m *Post) GetCreator() string {
-	if m != nil {
-		return m.Creator
-	}
-	return ""
-}
-
-func (m *Post) GetId() uint64 {
-	if m != nil {
-		return m.Id
-	}
-	return 0
-}
-
-func init() {
-	proto.RegisterType((*Post)(nil), "blog.blog.Post")
-}
-
-func init() { proto.RegisterFile("blog/blog/post.proto", fileDescriptor_8f060607f92e3b72) }
-
-var fileDescriptor_8f060607f92e3b72 = []byte{
-	// 166 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x49, 0xca, 0xc9, 0x4f,
-	0xd7, 0x07, 0x13, 0x05, 0xf9, 0xc5, 0x25, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x9c, 0x20,
-	0x01, 0x3d, 0x10, 0xa1, 0x14, 0xc5, 0xc5, 0x12, 0x90, 0x5f, 0x5c, 0x22, 0x24, 0xc2, 0xc5, 0x5a,
-	0x92, 0x59, 0x92, 0x93, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0x04, 0xe1, 0x08, 0x09, 0x71,
-	0xb1, 0x24, 0xe5, 0xa7, 0x54, 0x4a, 0x30, 0x81, 0x05, 0xc1, 0x6c, 0x21, 0x09, 0x2e, 0xf6, 0xe4,
-	0xa2, 0xd4, 0xc4, 0x92, 0xfc, 0x22, 0x09, 0x66, 0xb0, 0x30, 0x8c, 0x2b, 0xc4, 0xc7, 0xc5, 0x94,
-	0x99, 0x22, 0xc1, 0xa2, 0xc0, 0xa8, 0xc1, 0x12, 0xc4, 0x94, 0x99, 0xe2, 0xa4, 0x7d, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x82, 0x60, 0x17, 0x55, 0x40, 0x1c, 0x56, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0x9a, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xfa, 0xbd,
-	0x65, 0x52, 0xb2, 0x00, 0x00, 0x00,
-}
-
-func (m *Post) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Post) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Post) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintPost(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Body) > 0 {
-		i -= len(m.Body)
-		copy(dAtA[i:], m.Body)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.Body)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintPost(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
+	}
+}
+func (m *Post) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Post.Merge(m, src)
+}
+func (m *Post) XXX_Size() int {
+	return m.Size()
+}
+func (m *Post) XXX_DiscardUnknown() {
+	xxx_messageInfo_Post.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Post proto.InternalMessageInfo
+
+func (m *Post) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Post) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *Post) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Post) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Post) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *Post) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *Post) GetUpdatedAt() time.Time {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return time.Time{}
+}
+
+func (m *Post) GetUpdatedHeight() int64 {
+	if m != nil {
+		return m.UpdatedHeight
+	}
+	return 0
+}
+
+func (m *Post) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *Post) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *Post) GetDeletedAt() time.Time {
+	if m != nil {
+		return m.DeletedAt
+	}
+	return time.Time{}
+}
+
+func (m *Post) GetPurgeAt() time.Time {
+	if m != nil {
+		return m.PurgeAt
+	}
+	return time.Time{}
+}
+
+func (m *Post) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Post) GetStatus() PostStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PostStatus_POST_STATUS_UNSPECIFIED
+}
+
+func (m *Post) GetPublishAt() time.Time {
+	if m != nil {
+		return m.PublishAt
+	}
+	return time.Time{}
+}
+
+func (m *Post) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func (m *Post) GetTips() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Tips
+	}
+	return nil
+}
+
+func (m *Post) GetDeletedBy() string {
+	if m != nil {
+		return m.DeletedBy
+	}
+	return ""
+}
+
+func (m *Post) GetOriginalAuthor() string {
+	if m != nil {
+		return m.OriginalAuthor
+	}
+	return ""
+}
+
+func (m *Post) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return ""
+}
+
+func (m *Post) GetEditors() []string {
+	if m != nil {
+		return m.Editors
+	}
+	return nil
+}
+
+func (m *Post) GetHidden() bool {
+	if m != nil {
+		return m.Hidden
+	}
+	return false
+}
+
+func (m *Post) GetHideReason() HideReason {
+	if m != nil {
+		return m.HideReason
+	}
+	return HideReason_HIDE_REASON_UNSPECIFIED
+}
+
+func (m *Post) GetHiddenBy() string {
+	if m != nil {
+		return m.HiddenBy
+	}
+	return ""
+}
+
+func (m *Post) GetOfficial() bool {
+	if m != nil {
+		return m.Official
+	}
+	return false
+}
+
+// PostRevision is a previous version of a post, saved when the post is
+// updated.
+type PostRevision struct {
+	PostId   uint64 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	Title    string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Body     string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	Creator  string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	// created_at is the block time at which this version was written.
+	CreatedAt time.Time `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// created_height is the block height at which this version was written.
+	CreatedHeight int64    `protobuf:"varint,7,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	Tags          []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (m *PostRevision) Reset()         { *m = PostRevision{} }
+func (m *PostRevision) String() string { return proto.CompactTextString(m) }
+func (*PostRevision) ProtoMessage()    {}
+func (*PostRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f060607f92e3b72, []int{1}
+}
+func (m *PostRevision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PostRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PostRevision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PostRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PostRevision.Merge(m, src)
+}
+func (m *PostRevision) XXX_Size() int {
+	return m.Size()
+}
+func (m *PostRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_PostRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PostRevision proto.InternalMessageInfo
+
+func (m *PostRevision) GetPostId() uint64 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+func (m *PostRevision) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *PostRevision) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *PostRevision) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+func (m *PostRevision) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *PostRevision) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *PostRevision) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *PostRevision) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("blog.blog.PostStatus", PostStatus_name, PostStatus_value)
+	proto.RegisterEnum("blog.blog.HideReason", HideReason_name, HideReason_value)
+	proto.RegisterType((*Post)(nil), "blog.blog.Post")
+	proto.RegisterType((*PostRevision)(nil), "blog.blog.PostRevision")
+}
+
+func init() { proto.RegisterFile("blog/blog/post.proto", fileDescriptor_8f060607f92e3b72) }
+
+var fileDescriptor_8f060607f92e3b72 = []byte{
+	// 883 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x6f, 0xe2, 0x46,
+	0x14, 0xc7, 0x40, 0xf8, 0x33, 0x49, 0x58, 0x67, 0x96, 0x84, 0x09, 0xab, 0x12, 0xb4, 0x55, 0x55,
+	0xb4, 0xd5, 0xda, 0xdd, 0xad, 0x54, 0xa9, 0xa7, 0xca, 0x80, 0x53, 0x50, 0xd9, 0x80, 0x6c, 0xe7,
+	0xd2, 0x8b, 0x65, 0xe3, 0x89, 0x19, 0x95, 0x78, 0x2c, 0xcf, 0xb0, 0x2d, 0x9f, 0xa0, 0xd7, 0xfd,
+	0x0c, 0x3d, 0xf6, 0x4b, 0xf4, 0xba, 0xc7, 0x3d, 0xf6, 0xd4, 0xad, 0x92, 0x2f, 0x52, 0xcd, 0xd8,
+	0x26, 0x90, 0x46, 0x95, 0x22, 0xed, 0xc5, 0x9e, 0xf7, 0x7b, 0x7f, 0xc7, 0xef, 0xf7, 0x9e, 0x41,
+	0xd3, 0x5f, 0xd2, 0x50, 0x97, 0x8f, 0x98, 0x32, 0xae, 0xc5, 0x09, 0xe5, 0x14, 0xd6, 0x05, 0xa0,
+	0x89, 0x47, 0xbb, 0x19, 0xd2, 0x90, 0x4a, 0x54, 0x17, 0xa7, 0xd4, 0xa0, 0x7d, 0x16, 0x52, 0x1a,
+	0x2e, 0xb1, 0x2e, 0x25, 0x7f, 0x75, 0xa5, 0x73, 0x72, 0x8d, 0x19, 0xf7, 0xae, 0xe3, 0xcc, 0xa0,
+	0x33, 0xa7, 0xec, 0x9a, 0x32, 0xdd, 0xf7, 0x18, 0xd6, 0xdf, 0xbe, 0xf2, 0x31, 0xf7, 0x5e, 0xe9,
+	0x73, 0x4a, 0xa2, 0x54, 0xff, 0xfc, 0xf7, 0x1a, 0x28, 0xcf, 0x28, 0xe3, 0xb0, 0x09, 0xf6, 0x38,
+	0xe1, 0x4b, 0x8c, 0x94, 0xae, 0xd2, 0xab, 0x5b, 0xa9, 0x00, 0x21, 0x28, 0xfb, 0x34, 0x58, 0xa3,
+	0xa2, 0x04, 0xe5, 0x19, 0x22, 0x50, 0x9d, 0x27, 0xd8, 0xe3, 0x34, 0x41, 0x25, 0x09, 0xe7, 0x22,
+	0x6c, 0x80, 0x22, 0x09, 0x50, 0xb9, 0xab, 0xf4, 0xca, 0x56, 0x91, 0x04, 0x70, 0x00, 0x80, 0x54,
+	0xe1, 0xc0, 0xf5, 0x38, 0xda, 0xeb, 0x2a, 0xbd, 0xfd, 0xd7, 0x6d, 0x2d, 0x2d, 0x59, 0xcb, 0x4b,
+	0xd6, 0x9c, 0xbc, 0xe4, 0x7e, 0xed, 0xfd, 0xdf, 0x67, 0x85, 0x77, 0x1f, 0xcf, 0x14, 0xab, 0x9e,
+	0xf9, 0x19, 0x1c, 0x7e, 0x01, 0x1a, 0x79, 0x90, 0x05, 0x26, 0xe1, 0x82, 0xa3, 0x4a, 0x57, 0xe9,
+	0x95, 0xac, 0xc3, 0x0c, 0x1d, 0x49, 0x50, 0xe4, 0x5a, 0xc5, 0x41, 0x9e, 0xab, 0xfa, 0x98, 0x5c,
+	0x99, 0x5f, 0x9a, 0x2b, 0x0f, 0x92, 0xe5, 0xaa, 0xa5, 0xb9, 0x32, 0x34, 0xcb, 0xd5, 0x06, 0xb5,
+	0x04, 0xbf, 0x25, 0x8c, 0xd0, 0x08, 0xd5, 0xe5, 0x6d, 0x37, 0xb2, 0xf8, 0x3a, 0x01, 0x5e, 0x62,
+	0x8e, 0x03, 0x04, 0xba, 0x4a, 0xaf, 0x66, 0xe5, 0xa2, 0xa8, 0x30, 0x3b, 0x8a, 0x0a, 0xf7, 0x1f,
+	0x53, 0x61, 0xe6, 0x67, 0x70, 0xf8, 0x3d, 0xa8, 0xc5, 0xab, 0x24, 0xc4, 0x22, 0xc4, 0xc1, 0x23,
+	0x42, 0x54, 0xa5, 0x97, 0xc1, 0x45, 0x47, 0xb9, 0x17, 0x32, 0x74, 0xd8, 0x2d, 0x89, 0x8e, 0x8a,
+	0x33, 0x7c, 0x09, 0x2a, 0x8c, 0x7b, 0x7c, 0xc5, 0x50, 0xa3, 0xab, 0xf4, 0x1a, 0xaf, 0x8f, 0xb5,
+	0x0d, 0xef, 0x34, 0x41, 0x0e, 0x5b, 0x2a, 0xad, 0xcc, 0x48, 0x5c, 0x24, 0x5e, 0xf9, 0x4b, 0xc2,
+	0x16, 0xa2, 0x8a, 0x27, 0x8f, 0xb9, 0x48, 0xe6, 0x67, 0x70, 0xf8, 0x9d, 0xf8, 0x4e, 0x31, 0x65,
+	0x84, 0x23, 0x55, 0x46, 0x38, 0xd5, 0x52, 0xaa, 0x6a, 0x82, 0xaa, 0x5a, 0x46, 0x55, 0x6d, 0x40,
+	0x49, 0xd4, 0x2f, 0x8b, 0x00, 0x56, 0x6e, 0x0f, 0x5d, 0x50, 0xe6, 0x24, 0x66, 0xe8, 0xa8, 0x5b,
+	0xfa, 0x7f, 0xbf, 0xaf, 0x85, 0xdf, 0x1f, 0x1f, 0xcf, 0x7a, 0x21, 0xe1, 0x8b, 0x95, 0xaf, 0xcd,
+	0xe9, 0xb5, 0x9e, 0xcd, 0x43, 0xfa, 0x7a, 0xc9, 0x82, 0x9f, 0x75, 0xbe, 0x8e, 0x31, 0x93, 0x0e,
+	0xcc, 0x92, 0x81, 0xe1, 0x67, 0x77, 0x9d, 0xf2, 0xd7, 0x08, 0x4a, 0x92, 0xe7, 0x3d, 0xe8, 0xaf,
+	0xe1, 0x97, 0xe0, 0x09, 0x4d, 0x48, 0x48, 0x22, 0x6f, 0xe9, 0x7a, 0x2b, 0xbe, 0xa0, 0x09, 0x7a,
+	0x2a, 0x6d, 0x1a, 0x39, 0x6c, 0x48, 0x14, 0x7e, 0x0e, 0x0e, 0x63, 0x1c, 0x05, 0x24, 0x0a, 0x5d,
+	0xfa, 0x4b, 0x84, 0x13, 0xd4, 0x94, 0x66, 0x07, 0x19, 0x38, 0x15, 0x98, 0x20, 0x0c, 0x0e, 0x08,
+	0xa7, 0x09, 0x43, 0xc7, 0xb2, 0x27, 0xb9, 0x08, 0x4f, 0x40, 0x65, 0x41, 0x82, 0x00, 0x47, 0xe8,
+	0x44, 0x32, 0x29, 0x93, 0xe0, 0xb7, 0x60, 0x7f, 0x41, 0x02, 0xec, 0x26, 0xd8, 0x63, 0x34, 0x42,
+	0xad, 0xff, 0xf4, 0x6c, 0x44, 0x02, 0x6c, 0x49, 0xa5, 0x05, 0x16, 0x9b, 0x33, 0x7c, 0x06, 0xea,
+	0x69, 0x04, 0x71, 0x2b, 0x24, 0x4b, 0xa9, 0xa5, 0x40, 0x7f, 0x2d, 0x38, 0x4d, 0xaf, 0xae, 0xc8,
+	0x9c, 0x78, 0x4b, 0x74, 0x2a, 0xd3, 0x6d, 0xe4, 0xe7, 0xbf, 0x15, 0xc1, 0x81, 0xe0, 0x81, 0x95,
+	0x93, 0xbc, 0x05, 0xaa, 0x62, 0x4b, 0xb9, 0x24, 0x90, 0xeb, 0xa2, 0x6c, 0x55, 0x84, 0x38, 0x0e,
+	0x76, 0x26, 0xa3, 0x78, 0x6f, 0x32, 0x36, 0x1b, 0xa6, 0xf4, 0xd0, 0x86, 0x29, 0x3f, 0xbc, 0x61,
+	0xf6, 0x76, 0x37, 0xcc, 0xee, 0x46, 0xa9, 0x7c, 0xaa, 0x8d, 0x52, 0x7d, 0x68, 0xa3, 0xe4, 0x93,
+	0x52, 0xbb, 0x9b, 0x94, 0x17, 0x2b, 0x00, 0xee, 0x06, 0x02, 0x3e, 0x03, 0xad, 0xd9, 0xd4, 0x76,
+	0x5c, 0xdb, 0x31, 0x9c, 0x4b, 0xdb, 0xbd, 0xbc, 0xb0, 0x67, 0xe6, 0x60, 0x7c, 0x3e, 0x36, 0x87,
+	0x6a, 0x01, 0x1e, 0x83, 0xa3, 0x6d, 0xe5, 0xd0, 0x32, 0xce, 0x1d, 0x55, 0x81, 0xa7, 0xe0, 0x78,
+	0x1b, 0x9e, 0x5d, 0xf6, 0x27, 0x63, 0x7b, 0x64, 0x0e, 0xd5, 0x22, 0x44, 0xa0, 0xb9, 0x1b, 0x6e,
+	0x32, 0xb6, 0x1d, 0x73, 0xa8, 0x96, 0x5e, 0xfc, 0xa9, 0x00, 0x30, 0xda, 0x6e, 0x64, 0x6b, 0x34,
+	0x1e, 0x9a, 0xae, 0x65, 0x1a, 0xf6, 0xf4, 0xe2, 0x5e, 0xde, 0x26, 0x50, 0xb7, 0x95, 0xf6, 0xcc,
+	0x78, 0xa3, 0x2a, 0xb0, 0x0d, 0x4e, 0xb6, 0xd1, 0x91, 0x61, 0x19, 0xb6, 0xfd, 0xc6, 0xbc, 0x70,
+	0xd4, 0x22, 0x6c, 0x81, 0xa7, 0xdb, 0xba, 0xf1, 0x64, 0x62, 0xfe, 0x60, 0x4c, 0xd4, 0x92, 0xa8,
+	0x75, 0x5b, 0x31, 0x3d, 0x3f, 0x77, 0x9d, 0xe9, 0x6c, 0x3c, 0x50, 0xcb, 0xe2, 0x76, 0x3b, 0x2a,
+	0x67, 0x64, 0x5a, 0xea, 0xde, 0xfd, 0x50, 0xfd, 0xc9, 0x74, 0xf0, 0xa3, 0x39, 0x54, 0x2b, 0xfd,
+	0xaf, 0xde, 0xdf, 0x74, 0x94, 0x0f, 0x37, 0x1d, 0xe5, 0x9f, 0x9b, 0x8e, 0xf2, 0xee, 0xb6, 0x53,
+	0xf8, 0x70, 0xdb, 0x29, 0xfc, 0x75, 0xdb, 0x29, 0xfc, 0x74, 0x24, 0x7f, 0x7a, 0xbf, 0xa6, 0xff,
+	0x3e, 0x39, 0x8b, 0x7e, 0x45, 0x76, 0xf2, 0x9b, 0x7f, 0x07, 0x00, 0x45, 0xcd, 0x5e, 0xd7, 0x15,
+	0x07, 0x00, 0x00,
+}
+
+func (m *Post) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Post) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Post) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Official {
+		i--
+		if m.Official {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if len(m.HiddenBy) > 0 {
+		i -= len(m.HiddenBy)
+		copy(dAtA[i:], m.HiddenBy)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.HiddenBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if m.HideReason != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.HideReason))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.Hidden {
+		i--
+		if m.Hidden {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.Editors) > 0 {
+		for iNdEx := len(m.Editors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Editors[iNdEx])
+			copy(dAtA[i:], m.Editors[iNdEx])
+			i = encodeVarintPost(dAtA, i, uint64(len(m.Editors[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.OriginalAuthor) > 0 {
+		i -= len(m.OriginalAuthor)
+		copy(dAtA[i:], m.OriginalAuthor)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.OriginalAuthor)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.DeletedBy) > 0 {
+		i -= len(m.DeletedBy)
+		copy(dAtA[i:], m.DeletedBy)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.DeletedBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Tips) > 0 {
+		for iNdEx := len(m.Tips) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tips[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPost(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PublishAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PublishAt):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPost(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x7a
+	if m.Status != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintPost(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PurgeAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PurgeAt):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintPost(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x62
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DeletedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DeletedAt):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintPost(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x5a
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Revision != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.UpdatedHeight != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.UpdatedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintPost(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x3a
+	if m.CreatedHeight != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintPost(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	if m.Id != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PostRevision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PostRevision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PostRevision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintPost(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintPost(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintPost(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PostId != 0 {
+		i = encodeVarintPost(dAtA, i, uint64(m.PostId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPost(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPost(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Post) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovPost(uint64(m.Id))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovPost(uint64(l))
+	if m.CreatedHeight != 0 {
+		n += 1 + sovPost(uint64(m.CreatedHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdatedAt)
+	n += 1 + l + sovPost(uint64(l))
+	if m.UpdatedHeight != 0 {
+		n += 1 + sovPost(uint64(m.UpdatedHeight))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPost(uint64(m.Revision))
+	}
+	if m.Deleted {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DeletedAt)
+	n += 1 + l + sovPost(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PurgeAt)
+	n += 1 + l + sovPost(uint64(l))
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovPost(uint64(m.Status))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PublishAt)
+	n += 1 + l + sovPost(uint64(l))
+	l = m.Deposit.Size()
+	n += 2 + l + sovPost(uint64(l))
+	if len(m.Tips) > 0 {
+		for _, e := range m.Tips {
+			l = e.Size()
+			n += 2 + l + sovPost(uint64(l))
+		}
+	}
+	l = len(m.DeletedBy)
+	if l > 0 {
+		n += 2 + l + sovPost(uint64(l))
+	}
+	l = len(m.OriginalAuthor)
+	if l > 0 {
+		n += 2 + l + sovPost(uint64(l))
+	}
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 2 + l + sovPost(uint64(l))
+	}
+	if len(m.Editors) > 0 {
+		for _, s := range m.Editors {
+			l = len(s)
+			n += 2 + l + sovPost(uint64(l))
+		}
+	}
+	if m.Hidden {
+		n += 3
+	}
+	if m.HideReason != 0 {
+		n += 2 + sovPost(uint64(m.HideReason))
+	}
+	l = len(m.HiddenBy)
+	if l > 0 {
+		n += 2 + l + sovPost(uint64(l))
+	}
+	if m.Official {
+		n += 3
+	}
+	return n
+}
+
+func (m *PostRevision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostId != 0 {
+		n += 1 + sovPost(uint64(m.PostId))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovPost(uint64(m.Revision))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPost(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovPost(uint64(l))
+	if m.CreatedHeight != 0 {
+		n += 1 + sovPost(uint64(m.CreatedHeight))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovPost(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovPost(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPost(x uint64) (n int) {
+	return sovPost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Post) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Post: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Post: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedHeight", wireType)
+			}
+			m.UpdatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DeletedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurgeAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PurgeAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PostStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PublishAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tips", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tips = append(m.Tips, types.Coin{})
+			if err := m.Tips[len(m.Tips)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeletedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeletedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAuthor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalAuthor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Editors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Editors = append(m.Editors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hidden", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hidden = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HideReason", wireType)
+			}
+			m.HideReason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HideReason |= HideReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HiddenBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HiddenBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Official", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Official = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintPost(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPost(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Post) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
-	}
-	l = len(m.Body)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
-	}
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovPost(uint64(l))
-	}
-	if m.Id != 0 {
-		n += 1 + sovPost(uint64(m.Id))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovPost(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPost(x uint64) (n int) {
-	return sovPost(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Post) Unmarshal(dAtA []byte) error {
+func (m *PostRevision) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -224,13 +1769,51 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Post: wiretype end group for non-group")
+			return fmt.Errorf("proto: PostRevision: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Post: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PostRevision: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			m.PostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
@@ -262,7 +1845,7 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 			}
 			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
@@ -294,7 +1877,7 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 			}
 			m.Body = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
@@ -326,11 +1909,44 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
 			}
-			m.Id = 0
+			m.CreatedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPost
@@ -340,11 +1956,43 @@ func (m *Post) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPost(dAtA[iNdEx:])
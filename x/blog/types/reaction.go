@@ -0,0 +1,17 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ValidateReactionKind returns an error unless kind is one of the known
+// reaction kinds.
+func ValidateReactionKind(kind ReactionKind) error {
+	if kind == ReactionKind_REACTION_KIND_UNSPECIFIED {
+		return errorsmod.Wrap(ErrInvalidReactionKind, "reaction kind must be specified")
+	}
+	if _, ok := ReactionKind_name[int32(kind)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidReactionKind, "unknown reaction kind %d", kind)
+	}
+	return nil
+}
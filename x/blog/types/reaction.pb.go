@@ -0,0 +1,720 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: blog/blog/reaction.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReactionKind is the kind of reaction an account leaves on a post.
+type ReactionKind int32
+
+const (
+	ReactionKind_REACTION_KIND_UNSPECIFIED ReactionKind = 0
+	ReactionKind_REACTION_KIND_LIKE        ReactionKind = 1
+	ReactionKind_REACTION_KIND_LOVE        ReactionKind = 2
+	ReactionKind_REACTION_KIND_INSIGHTFUL  ReactionKind = 3
+	ReactionKind_REACTION_KIND_FUNNY       ReactionKind = 4
+)
+
+var ReactionKind_name = map[int32]string{
+	0: "REACTION_KIND_UNSPECIFIED",
+	1: "REACTION_KIND_LIKE",
+	2: "REACTION_KIND_LOVE",
+	3: "REACTION_KIND_INSIGHTFUL",
+	4: "REACTION_KIND_FUNNY",
+}
+
+var ReactionKind_value = map[string]int32{
+	"REACTION_KIND_UNSPECIFIED": 0,
+	"REACTION_KIND_LIKE":        1,
+	"REACTION_KIND_LOVE":        2,
+	"REACTION_KIND_INSIGHTFUL":  3,
+	"REACTION_KIND_FUNNY":       4,
+}
+
+func (x ReactionKind) String() string {
+	return proto.EnumName(ReactionKind_name, int32(x))
+}
+
+func (ReactionKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_111347cc35b7dcc0, []int{0}
+}
+
+// Reaction is a reaction left by an account on a post. An account has at
+// most one reaction of each kind per post.
+type Reaction struct {
+	PostId  uint64       `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	Creator string       `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Kind    ReactionKind `protobuf:"varint,3,opt,name=kind,proto3,enum=blog.blog.ReactionKind" json:"kind,omitempty"`
+	// created_at is the block time at which the reaction was left.
+	CreatedAt time.Time `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3,stdtime" json:"created_at"`
+	// created_height is the block height at which the reaction was left.
+	CreatedHeight int64 `protobuf:"varint,5,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+}
+
+func (m *Reaction) Reset()         { *m = Reaction{} }
+func (m *Reaction) String() string { return proto.CompactTextString(m) }
+func (*Reaction) ProtoMessage()    {}
+func (*Reaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_111347cc35b7dcc0, []int{0}
+}
+func (m *Reaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Reaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reaction.Merge(m, src)
+}
+func (m *Reaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Reaction proto.InternalMessageInfo
+
+func (m *Reaction) GetPostId() uint64 {
+	if m != nil {
+		return m.PostId
+	}
+	return 0
+}
+
+func (m *Reaction) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Reaction) GetKind() ReactionKind {
+	if m != nil {
+		return m.Kind
+	}
+	return ReactionKind_REACTION_KIND_UNSPECIFIED
+}
+
+func (m *Reaction) GetCreatedAt() time.Time {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return time.Time{}
+}
+
+func (m *Reaction) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+// ReactionCount is the number of reactions of a kind on a post.
+type ReactionCount struct {
+	Kind  ReactionKind `protobuf:"varint,1,opt,name=kind,proto3,enum=blog.blog.ReactionKind" json:"kind,omitempty"`
+	Count uint64       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (m *ReactionCount) Reset()         { *m = ReactionCount{} }
+func (m *ReactionCount) String() string { return proto.CompactTextString(m) }
+func (*ReactionCount) ProtoMessage()    {}
+func (*ReactionCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_111347cc35b7dcc0, []int{1}
+}
+func (m *ReactionCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReactionCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReactionCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReactionCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReactionCount.Merge(m, src)
+}
+func (m *ReactionCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReactionCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReactionCount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReactionCount proto.InternalMessageInfo
+
+func (m *ReactionCount) GetKind() ReactionKind {
+	if m != nil {
+		return m.Kind
+	}
+	return ReactionKind_REACTION_KIND_UNSPECIFIED
+}
+
+func (m *ReactionCount) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("blog.blog.ReactionKind", ReactionKind_name, ReactionKind_value)
+	proto.RegisterType((*Reaction)(nil), "blog.blog.Reaction")
+	proto.RegisterType((*ReactionCount)(nil), "blog.blog.ReactionCount")
+}
+
+func init() { proto.RegisterFile("blog/blog/reaction.proto", fileDescriptor_111347cc35b7dcc0) }
+
+var fileDescriptor_111347cc35b7dcc0 = []byte{
+	// 398 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xaa, 0xda, 0x40,
+	0x14, 0xc6, 0x33, 0xd7, 0xdc, 0x3f, 0x4e, 0x7b, 0x2f, 0xe9, 0x54, 0xea, 0x54, 0xda, 0x18, 0x84,
+	0x42, 0xa8, 0x90, 0x80, 0x7d, 0x02, 0x8d, 0xb1, 0x0e, 0x4a, 0x2c, 0xa3, 0x16, 0xda, 0x4d, 0x88,
+	0x26, 0x8d, 0xa1, 0x9a, 0x09, 0x71, 0x84, 0xf6, 0x2d, 0x5c, 0xf4, 0xa1, 0x5c, 0xba, 0x2a, 0x5d,
+	0xb5, 0x45, 0x5f, 0xa4, 0x64, 0x62, 0xa4, 0x42, 0x17, 0xdd, 0x1c, 0xf2, 0x7d, 0xe7, 0x3b, 0x87,
+	0x5f, 0x86, 0x03, 0xf1, 0x6c, 0xc9, 0x42, 0x53, 0x94, 0x34, 0xf0, 0xe6, 0x3c, 0x62, 0xb1, 0x91,
+	0xa4, 0x8c, 0x33, 0x54, 0xce, 0x4c, 0x23, 0x2b, 0xb5, 0x4a, 0xc8, 0x42, 0x26, 0x5c, 0x33, 0xfb,
+	0xca, 0x03, 0xb5, 0x7a, 0xc8, 0x58, 0xb8, 0x0c, 0x4c, 0xa1, 0x66, 0x9b, 0x4f, 0x26, 0x8f, 0x56,
+	0xc1, 0x9a, 0x7b, 0xab, 0x24, 0x0f, 0x34, 0xbe, 0x03, 0x78, 0x47, 0x4f, 0x4b, 0x51, 0x15, 0xde,
+	0x26, 0x6c, 0xcd, 0xdd, 0xc8, 0xc7, 0x40, 0x03, 0xba, 0x4c, 0x6f, 0x32, 0x49, 0x7c, 0x84, 0xe1,
+	0xed, 0x3c, 0x0d, 0x3c, 0xce, 0x52, 0x7c, 0xa5, 0x01, 0xbd, 0x4c, 0x0b, 0x89, 0x9a, 0x50, 0xfe,
+	0x1c, 0xc5, 0x3e, 0x2e, 0x69, 0x40, 0x7f, 0x68, 0x55, 0x8d, 0x33, 0x90, 0x51, 0x6c, 0x1d, 0x44,
+	0xb1, 0x4f, 0x45, 0x08, 0x59, 0x10, 0x8a, 0xb9, 0xc0, 0x77, 0x3d, 0x8e, 0x65, 0x0d, 0xe8, 0x8f,
+	0x5a, 0x35, 0x23, 0x47, 0x34, 0x0a, 0x44, 0x63, 0x52, 0x20, 0x76, 0xee, 0x76, 0x3f, 0xeb, 0xd2,
+	0xf6, 0x57, 0x1d, 0xd0, 0xf2, 0x69, 0xae, 0xcd, 0xd1, 0x2b, 0xf8, 0x50, 0x2c, 0x59, 0x04, 0x51,
+	0xb8, 0xe0, 0xf8, 0x5a, 0x03, 0x7a, 0x89, 0xde, 0x9f, 0xdc, 0xbe, 0x30, 0x1b, 0x14, 0xde, 0x17,
+	0x04, 0x16, 0xdb, 0xc4, 0xfc, 0x4c, 0x0a, 0xfe, 0x87, 0xb4, 0x02, 0xaf, 0xe7, 0xd9, 0x94, 0xf8,
+	0x5d, 0x99, 0xe6, 0xe2, 0xf5, 0x37, 0x00, 0x1f, 0xff, 0x1d, 0x46, 0x2f, 0xe1, 0x73, 0x6a, 0xb7,
+	0xad, 0x09, 0x19, 0x39, 0xee, 0x80, 0x38, 0x5d, 0x77, 0xea, 0x8c, 0xdf, 0xd9, 0x16, 0xe9, 0x11,
+	0xbb, 0xab, 0x48, 0xe8, 0x19, 0x44, 0x97, 0xed, 0x21, 0x19, 0xd8, 0x0a, 0xf8, 0x87, 0x3f, 0x7a,
+	0x6f, 0x2b, 0x57, 0xe8, 0x05, 0xc4, 0x97, 0x3e, 0x71, 0xc6, 0xe4, 0x6d, 0x7f, 0xd2, 0x9b, 0x0e,
+	0x95, 0x12, 0xaa, 0xc2, 0xa7, 0x97, 0xdd, 0xde, 0xd4, 0x71, 0x3e, 0x28, 0x72, 0xa7, 0xb9, 0x3b,
+	0xa8, 0x60, 0x7f, 0x50, 0xc1, 0xef, 0x83, 0x0a, 0xb6, 0x47, 0x55, 0xda, 0x1f, 0x55, 0xe9, 0xc7,
+	0x51, 0x95, 0x3e, 0x3e, 0x11, 0x47, 0xf3, 0x25, 0xbf, 0x1d, 0xfe, 0x35, 0x09, 0xd6, 0xb3, 0x1b,
+	0xf1, 0xce, 0x6f, 0xfe, 0x0c, 0x00, 0x69, 0xe4, 0xbb, 0x6a, 0x55, 0x02, 0x00, 0x00,
+}
+
+func (m *Reaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedHeight != 0 {
+		i = encodeVarintReaction(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintReaction(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.Kind != 0 {
+		i = encodeVarintReaction(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintReaction(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PostId != 0 {
+		i = encodeVarintReaction(dAtA, i, uint64(m.PostId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReactionCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReactionCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReactionCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintReaction(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Kind != 0 {
+		i = encodeVarintReaction(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReaction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReaction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Reaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PostId != 0 {
+		n += 1 + sovReaction(uint64(m.PostId))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovReaction(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovReaction(uint64(m.Kind))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreatedAt)
+	n += 1 + l + sovReaction(uint64(l))
+	if m.CreatedHeight != 0 {
+		n += 1 + sovReaction(uint64(m.CreatedHeight))
+	}
+	return n
+}
+
+func (m *ReactionCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovReaction(uint64(m.Kind))
+	}
+	if m.Count != 0 {
+		n += 1 + sovReaction(uint64(m.Count))
+	}
+	return n
+}
+
+func sovReaction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReaction(x uint64) (n int) {
+	return sovReaction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Reaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostId", wireType)
+			}
+			m.PostId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= ReactionKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReaction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReactionCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReactionCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReactionCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= ReactionKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReaction(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReaction
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReaction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReaction
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReaction
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReaction
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReaction        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReaction          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReaction = fmt.Errorf("proto: unexpected end of group")
+)
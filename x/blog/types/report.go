@@ -0,0 +1,30 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ValidateHideReason returns an error unless reason is one of the known hide
+// reasons.
+func ValidateHideReason(reason HideReason) error {
+	if reason == HideReason_HIDE_REASON_UNSPECIFIED {
+		return errorsmod.Wrap(ErrInvalidHideReason, "reason must be specified")
+	}
+	if _, ok := HideReason_name[int32(reason)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidHideReason, "unknown hide reason %d", reason)
+	}
+	return nil
+}
+
+// ValidateUserHideReason returns an error unless reason is a known hide reason
+// that moderators and reporters may give. The blocked reason is reserved for
+// posts hidden by the blocklist.
+func ValidateUserHideReason(reason HideReason) error {
+	if err := ValidateHideReason(reason); err != nil {
+		return err
+	}
+	if reason == HideReason_HIDE_REASON_BLOCKED {
+		return errorsmod.Wrap(ErrInvalidHideReason, "reason is reserved for blocked accounts")
+	}
+	return nil
+}
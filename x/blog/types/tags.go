@@ -0,0 +1,54 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NormalizeTag trims and lower-cases a tag. A normalized tag is made of
+// lowercase letters, digits and dashes only.
+func NormalizeTag(tag string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(tag))
+	if normalized == "" {
+		return "", errorsmod.Wrap(ErrInvalidTag, "tag must not be empty")
+	}
+	for _, r := range normalized {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+			return "", errorsmod.Wrapf(ErrInvalidTag, "tag %q may only contain letters, digits and dashes", tag)
+		}
+	}
+	return normalized, nil
+}
+
+// NormalizeTags normalizes every tag of a post and drops duplicates, keeping
+// the order in which the tags were first given.
+func NormalizeTags(tags []string) ([]string, error) {
+	var normalized []string
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		n, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if seen[n] {
+			continue
+		}
+		seen[n] = true
+		normalized = append(normalized, n)
+	}
+	return normalized, nil
+}
+
+// ValidateTags checks normalized tags against the tag limits in params.
+func (p Params) ValidateTags(tags []string) error {
+	if uint64(len(tags)) > p.MaxTags {
+		return errorsmod.Wrapf(ErrTooManyTags, "got %d tags, maximum is %d", len(tags), p.MaxTags)
+	}
+	for _, tag := range tags {
+		if uint64(len(tag)) > p.MaxTagLength {
+			return errorsmod.Wrapf(ErrTagTooLong, "tag %q is longer than %d bytes", tag, p.MaxTagLength)
+		}
+	}
+	return nil
+}